@@ -142,3 +142,154 @@ func (c *Client) AuthorizeApplications(ctx context.Context, id string, params Au
 
 	return nil
 }
+
+type ListScopesResponse struct {
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Scopes  []Scope `json:"scopes"`
+}
+
+// https://kinde.com/api/docs/#get-api-scopes
+func (c *Client) ListScopes(ctx context.Context, id string) ([]Scope, error) {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/scopes", id)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListScopesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Scopes == nil {
+		response.Scopes = make([]Scope, 0)
+	}
+
+	return response.Scopes, nil
+}
+
+type GetScopeResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Scope   Scope  `json:"scope"`
+}
+
+// https://kinde.com/api/docs/#get-api-scope
+func (c *Client) GetScope(ctx context.Context, id string, scopeID string) (*Scope, error) {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/scopes/%s", id, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetScopeResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Scope, nil
+}
+
+type CreateScopeParams struct {
+	Key         string `json:"key"`
+	Description string `json:"description,omitempty"`
+}
+
+type CreateScopeResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	ID      string `json:"id"`
+}
+
+// https://kinde.com/api/docs/#create-api-scope
+func (c *Client) CreateScope(ctx context.Context, id string, params CreateScopeParams) (*Scope, error) {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/scopes", id)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateScopeResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	scope := Scope{
+		ID:          response.ID,
+		Key:         params.Key,
+		Description: params.Description,
+	}
+	return &scope, nil
+}
+
+type UpdateScopeParams struct {
+	Description string `json:"description"`
+}
+
+// https://kinde.com/api/docs/#update-api-scope
+//
+// note: the key of a scope cannot be changed
+func (c *Client) UpdateScope(ctx context.Context, id string, scopeID string, params UpdateScopeParams) error {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/scopes/%s", id, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#delete-api-scope
+func (c *Client) DeleteScope(ctx context.Context, id string, scopeID string) error {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/scopes/%s", id, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GrantApplicationScope grants a scope of an api to an application already
+// authorized with AuthorizeApplications
+//
+// https://kinde.com/api/docs/#add-scope-to-api-application
+func (c *Client) GrantApplicationScope(ctx context.Context, id string, applicationID string, scopeID string) error {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/applications/%s/scopes/%s", id, applicationID, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeApplicationScope revokes a scope of an api from an application
+//
+// https://kinde.com/api/docs/#delete-scope-from-api-application
+func (c *Client) RevokeApplicationScope(ctx context.Context, id string, applicationID string, scopeID string) error {
+	endpoint := fmt.Sprintf("/api/v1/apis/%s/applications/%s/scopes/%s", id, applicationID, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
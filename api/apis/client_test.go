@@ -50,3 +50,43 @@ func TestAuthorizeApplications(t *testing.T) {
 	_ = client.AuthorizeApplications(context.TODO(), "1", apis.AuthorizeApplicationsParams{})
 	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/apis/1/applications"))
 }
+
+func TestListScopes(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := apis.New(client.New(context.TODO(), nil))
+	_, _ = client.ListScopes(context.TODO(), "1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/apis/1/scopes"))
+}
+
+func TestCreateScope(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := apis.New(client.New(context.TODO(), nil))
+	testServer.Handle(t, http.MethodPost, "/api/v1/apis/1/scopes", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"key":"read:invoices","description":"Read invoices"}`, string(body))
+		return http.StatusOK, `{"code":"OK","id":"scope_1"}`
+	})
+	scope, err := client.CreateScope(context.TODO(), "1", apis.CreateScopeParams{Key: "read:invoices", Description: "Read invoices"})
+	assert.NoError(t, err)
+	assert.Equal(t, "scope_1", scope.ID)
+	assert.Equal(t, "read:invoices", scope.Key)
+}
+
+func TestUpdateDeleteScope(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := apis.New(client.New(context.TODO(), nil))
+	_, _ = client.GetScope(context.TODO(), "1", "2")
+	_ = client.UpdateScope(context.TODO(), "1", "2", apis.UpdateScopeParams{Description: "description"})
+	_ = client.DeleteScope(context.TODO(), "1", "2")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/apis/1/scopes/2"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/apis/1/scopes/2"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/apis/1/scopes/2"))
+}
+
+func TestApplicationScopes(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := apis.New(client.New(context.TODO(), nil))
+	_ = client.GrantApplicationScope(context.TODO(), "1", "app_1", "scope_1")
+	_ = client.RevokeApplicationScope(context.TODO(), "1", "app_1", "scope_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/apis/1/applications/app_1/scopes/scope_1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/apis/1/applications/app_1/scopes/scope_1"))
+}
@@ -15,3 +15,10 @@ type APIApplication struct {
 	Type     string `json:"type"`
 	IsActive *bool  `json:"is_active"`
 }
+
+// https://kinde.com/api/docs/#get-api-scopes
+type Scope struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Description string `json:"description,omitempty"`
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 
 	"github.com/nxt-fwd/kinde-go/internal/client"
 	"github.com/nxt-fwd/kinde-go/internal/enum"
@@ -22,8 +24,15 @@ type ListParams struct {
 	Sort      ListSortMethod
 	PageSize  int
 	NextToken string
+	// Hydrate fetches the full record of every listed application
+	Hydrate bool
+	// HydrateConcurrency bounds the number of concurrent requests made when
+	// hydrating, defaults to DefaultHydrateConcurrency
+	HydrateConcurrency int
 }
 
+const DefaultHydrateConcurrency = 4
+
 type ListSortMethod string
 
 const (
@@ -49,9 +58,13 @@ type ListResponse struct {
 	Applications []Application `json:"applications"`
 }
 
+func (r ListResponse) GetNextToken() string { return r.NextToken }
+
+func (r ListResponse) GetData() []Application { return r.Applications }
+
 // https://kinde.com/api/docs/#get-applications
 //
-// note: only id, name, and type will be populated
+// note: only id, name, and type will be populated unless params.Hydrate is set
 func (c *Client) List(ctx context.Context, params ListParams) ([]Application, error) {
 	query := url.Values{}
 	if params.Sort != "" {
@@ -77,9 +90,115 @@ func (c *Client) List(ctx context.Context, params ListParams) ([]Application, er
 		return nil, err
 	}
 
+	if params.Hydrate {
+		return c.Hydrate(ctx, response.Applications, params.HydrateConcurrency)
+	}
+
 	return response.Applications, nil
 }
 
+// Paginator iterates over pages of applications, hydrating every page when
+// ListParams.Hydrate is set
+type Paginator struct {
+	client      *Client
+	pages       *client.Paginator[Application, ListResponse]
+	hydrate     bool
+	concurrency int
+}
+
+func (p *Paginator) HasNext() bool {
+	return p.pages.HasNext()
+}
+
+func (p *Paginator) Next(ctx context.Context) ([]Application, error) {
+	applications, err := p.pages.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.hydrate {
+		return p.client.Hydrate(ctx, applications, p.concurrency)
+	}
+
+	return applications, nil
+}
+
+// ListPaginator returns a paginator iterating over every page of applications,
+// starting from params.NextToken when set
+func (c *Client) ListPaginator(params ListParams) *Paginator {
+	opts := client.PaginatorOptions{
+		Sort:      string(params.Sort),
+		PageSize:  params.PageSize,
+		NextToken: params.NextToken,
+	}
+
+	return &Paginator{
+		client:      c,
+		pages:       client.NewPaginator[Application, ListResponse](c, "/api/v1/applications", opts),
+		hydrate:     params.Hydrate,
+		concurrency: params.HydrateConcurrency,
+	}
+}
+
+// Hydrate replaces the partial applications returned by List with their full
+// records, fetching at most concurrency applications at a time. The order of
+// applications is preserved and the first error aborts the remaining requests.
+func (c *Client) Hydrate(ctx context.Context, applications []Application, concurrency int) ([]Application, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultHydrateConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	hydrated := make([]Application, len(applications))
+	sem := make(chan struct{}, concurrency)
+	errs := make(chan error, 1)
+	var wg sync.WaitGroup
+
+	for i, application := range applications {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			full, err := c.Get(ctx, id)
+			if err != nil {
+				select {
+				case errs <- fmt.Errorf("failed to hydrate application %s: %w", id, err):
+					cancel()
+				default:
+				}
+				return
+			}
+
+			hydrated[i] = *full
+		}(i, application.ID)
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return hydrated, nil
+}
+
 type CreateParams struct {
 	Name string `json:"name"`
 	Type Type   `json:"type"`
@@ -242,3 +361,228 @@ func (c *Client) DisableConnection(ctx context.Context, applicationID, connectio
 
 	return nil
 }
+
+// callback url endpoints share the same shape, only the path segment and the
+// list key of the response differ
+const (
+	redirectURLsPath = "auth_redirect_urls"
+	logoutURLsPath   = "auth_logout_urls"
+)
+
+type CallbackURLsParams struct {
+	URLs []string `json:"urls"`
+}
+
+type ListRedirectURLsResponse struct {
+	Code         string   `json:"code"`
+	Message      string   `json:"message"`
+	RedirectURLs []string `json:"redirect_urls"`
+}
+
+type ListLogoutURLsResponse struct {
+	Code       string   `json:"code"`
+	Message    string   `json:"message"`
+	LogoutURLs []string `json:"logout_urls"`
+}
+
+func (c *Client) mutateCallbackURLs(ctx context.Context, id, path, method string, urls []string) error {
+	// only replacing accepts an empty list, adding or deleting nothing would
+	// send a request without effect
+	if method != http.MethodPut && len(urls) == 0 {
+		return fmt.Errorf("no urls provided")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/%s", id, path)
+
+	var query url.Values
+	var body any
+	if method == http.MethodDelete {
+		// the urls are sent as a single comma separated value, a url
+		// containing a comma would be split into several
+		for _, u := range urls {
+			if strings.Contains(u, ",") {
+				return fmt.Errorf("cannot delete url containing a comma: %s", u)
+			}
+		}
+
+		query = url.Values{}
+		query.Set("urls", strings.Join(urls, ","))
+	} else {
+		body = CallbackURLsParams{URLs: urls}
+	}
+
+	req, err := c.NewRequest(ctx, method, endpoint, query, body)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#list-callback-urls
+func (c *Client) ListRedirectURLs(ctx context.Context, id string) ([]string, error) {
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/%s", id, redirectURLsPath)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListRedirectURLsResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.RedirectURLs == nil {
+		response.RedirectURLs = make([]string, 0)
+	}
+
+	return response.RedirectURLs, nil
+}
+
+// AddRedirectURLs registers additional redirect urls, existing urls are kept.
+// At least one url is required.
+//
+// https://kinde.com/api/docs/#add-redirect-callback-urls
+func (c *Client) AddRedirectURLs(ctx context.Context, id string, urls []string) error {
+	return c.mutateCallbackURLs(ctx, id, redirectURLsPath, http.MethodPost, urls)
+}
+
+// ReplaceRedirectURLs replaces every redirect url, an empty list removes all
+// of them
+//
+// https://kinde.com/api/docs/#replace-redirect-callback-urls
+func (c *Client) ReplaceRedirectURLs(ctx context.Context, id string, urls []string) error {
+	if urls == nil {
+		urls = []string{}
+	}
+
+	return c.mutateCallbackURLs(ctx, id, redirectURLsPath, http.MethodPut, urls)
+}
+
+// DeleteRedirectURLs removes specific redirect urls, other urls are kept.
+// At least one url is required and urls containing a comma are rejected as
+// kinde receives them as a single comma separated value, use
+// ReplaceRedirectURLs to remove them.
+//
+// https://kinde.com/api/docs/#delete-callback-urls
+func (c *Client) DeleteRedirectURLs(ctx context.Context, id string, urls []string) error {
+	return c.mutateCallbackURLs(ctx, id, redirectURLsPath, http.MethodDelete, urls)
+}
+
+// https://kinde.com/api/docs/#list-logout-urls
+func (c *Client) ListLogoutURLs(ctx context.Context, id string) ([]string, error) {
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/%s", id, logoutURLsPath)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListLogoutURLsResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.LogoutURLs == nil {
+		response.LogoutURLs = make([]string, 0)
+	}
+
+	return response.LogoutURLs, nil
+}
+
+// AddLogoutURLs registers additional logout urls, existing urls are kept.
+// At least one url is required.
+//
+// https://kinde.com/api/docs/#add-logout-redirect-urls
+func (c *Client) AddLogoutURLs(ctx context.Context, id string, urls []string) error {
+	return c.mutateCallbackURLs(ctx, id, logoutURLsPath, http.MethodPost, urls)
+}
+
+// ReplaceLogoutURLs replaces every logout url, an empty list removes all of
+// them
+//
+// https://kinde.com/api/docs/#replace-logout-redirect-urls
+func (c *Client) ReplaceLogoutURLs(ctx context.Context, id string, urls []string) error {
+	if urls == nil {
+		urls = []string{}
+	}
+
+	return c.mutateCallbackURLs(ctx, id, logoutURLsPath, http.MethodPut, urls)
+}
+
+// DeleteLogoutURLs removes specific logout urls, other urls are kept.
+// At least one url is required and urls containing a comma are rejected as
+// kinde receives them as a single comma separated value, use
+// ReplaceLogoutURLs to remove them.
+//
+// https://kinde.com/api/docs/#delete-logout-urls
+func (c *Client) DeleteLogoutURLs(ctx context.Context, id string, urls []string) error {
+	return c.mutateCallbackURLs(ctx, id, logoutURLsPath, http.MethodDelete, urls)
+}
+
+// TokenSettings configures the tokens issued to an application, lifetimes are
+// in seconds and nil fields are left unchanged
+type TokenSettings struct {
+	AccessTokenLifetime          *int  `json:"access_token_lifetime,omitempty"`
+	RefreshTokenLifetime         *int  `json:"refresh_token_lifetime,omitempty"`
+	IDTokenLifetime              *int  `json:"id_token_lifetime,omitempty"`
+	AuthenticatedSessionLifetime *int  `json:"authenticated_session_lifetime,omitempty"`
+	IsHasuraMappingEnabled       *bool `json:"is_hasura_mapping_enabled,omitempty"`
+}
+
+// https://kinde.com/api/docs/#update-application-tokens
+func (c *Client) UpdateTokens(ctx context.Context, id string, params TokenSettings) error {
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/tokens", id)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type RotateSecretResponse struct {
+	Code         string `json:"code"`
+	Message      string `json:"message"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// RotateSecret generates a new client secret for an application, the previous
+// secret stops working immediately. The returned application carries the new
+// ClientSecret, even when fetching the updated application fails, in which
+// case only ID and ClientSecret are set and the error is returned alongside.
+//
+// https://kinde.com/api/docs/#rotate-application-secret
+func (c *Client) RotateSecret(ctx context.Context, id string) (*Application, error) {
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/rotate_secret", id)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RotateSecretResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ClientSecret == "" {
+		return nil, fmt.Errorf("rotating secret for application %s returned no client secret", id)
+	}
+
+	application, err := c.Get(ctx, id)
+	if err != nil {
+		// The previous secret is already invalid, never drop the new one
+		return &Application{ID: id, ClientSecret: response.ClientSecret},
+			fmt.Errorf("failed to get application after rotating secret: %w", err)
+	}
+
+	application.ClientSecret = response.ClientSecret
+	return application, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/nxt-fwd/kinde-go/internal/client"
 	"github.com/nxt-fwd/kinde-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestList(t *testing.T) {
@@ -43,3 +44,189 @@ func TestDelete(t *testing.T) {
 	_ = client.Delete(context.TODO(), "1")
 	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/applications/1"))
 }
+
+func TestListRedirectURLs(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/1/auth_redirect_urls", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","redirect_urls":["https://example.com/callback"]}`
+	})
+	urls, err := client.ListRedirectURLs(context.TODO(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/callback"}, urls)
+}
+
+func TestAddRedirectURLs(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/applications/1/auth_redirect_urls", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"urls":["https://pr-1.example.com/callback"]}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.AddRedirectURLs(context.TODO(), "1", []string{"https://pr-1.example.com/callback"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/applications/1/auth_redirect_urls"))
+}
+
+func TestReplaceLogoutURLsWithEmptyList(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/applications/1/auth_logout_urls", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"urls":[]}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.ReplaceLogoutURLs(context.TODO(), "1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/applications/1/auth_logout_urls"))
+}
+
+func TestDeleteLogoutURLs(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodDelete, "/api/v1/applications/1/auth_logout_urls", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "https://a.example.com,https://b.example.com", query.Get("urls"))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.DeleteLogoutURLs(context.TODO(), "1", []string{"https://a.example.com", "https://b.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/applications/1/auth_logout_urls"))
+}
+
+func TestMutateCallbackURLsRejectsInvalidURLs(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+
+	err := client.AddRedirectURLs(context.TODO(), "1", nil)
+	assert.Error(t, err)
+
+	err = client.DeleteLogoutURLs(context.TODO(), "1", []string{})
+	assert.Error(t, err)
+
+	err = client.DeleteRedirectURLs(context.TODO(), "1", []string{"https://a.example.com/callback?ids=1,2"})
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodPost, "/api/v1/applications/1/auth_redirect_urls"))
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodDelete, "/api/v1/applications/1/auth_logout_urls"))
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodDelete, "/api/v1/applications/1/auth_redirect_urls"))
+}
+
+func TestUpdateTokens(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/applications/1/tokens", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"access_token_lifetime":3600,"refresh_token_lifetime":86400}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	accessTokenLifetime, refreshTokenLifetime := 3600, 86400
+	err := client.UpdateTokens(context.TODO(), "1", applications.TokenSettings{
+		AccessTokenLifetime:  &accessTokenLifetime,
+		RefreshTokenLifetime: &refreshTokenLifetime,
+	})
+	assert.NoError(t, err)
+}
+
+func TestRotateSecret(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/applications/1/rotate_secret", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","client_secret":"new_secret"}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/1", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","application":{"id":"1","type":"m2m","client_id":"client_id"}}`
+	})
+	application, err := client.RotateSecret(context.TODO(), "1")
+	assert.NoError(t, err)
+	require.NotNil(t, application)
+	assert.Equal(t, "client_id", application.ClientID)
+	assert.Equal(t, "new_secret", application.ClientSecret)
+}
+
+func TestRotateSecretGetFails(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/applications/1/rotate_secret", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","client_secret":"new_secret"}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/1", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusInternalServerError, `{"errors":[{"code":"INTERNAL_ERROR","message":"internal error"}]}`
+	})
+	application, err := client.RotateSecret(context.TODO(), "1")
+	assert.Error(t, err)
+	require.NotNil(t, application)
+	assert.Equal(t, "1", application.ID)
+	assert.Equal(t, "new_secret", application.ClientSecret)
+}
+
+func TestListHydrate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","applications":[{"id":"1"},{"id":"2"},{"id":"3"}]}`
+	})
+	for _, id := range []string{"1", "2", "3"} {
+		testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/"+id, func(header http.Header, query url.Values, body []byte) (int, string) {
+			return http.StatusOK, `{"code":"OK","application":{"id":"` + id + `","client_id":"client_` + id + `","language_key":"en","access_token_lifetime":3600}}`
+		})
+	}
+
+	res, err := client.List(context.TODO(), applications.ListParams{Hydrate: true, HydrateConcurrency: 2})
+	assert.NoError(t, err)
+	require.Len(t, res, 3)
+	for i, application := range res {
+		id := []string{"1", "2", "3"}[i]
+		assert.Equal(t, id, application.ID)
+		assert.Equal(t, "client_"+id, application.ClientID)
+		assert.Equal(t, "en", application.LanguageKey)
+		assert.Equal(t, 3600, application.AccessTokenLifetime)
+		assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/applications/"+id))
+	}
+}
+
+func TestListHydrateError(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","applications":[{"id":"1"}]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/1", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusNotFound, `{"errors":[{"code":"APPLICATION_INVALID","message":"Invalid application"}]}`
+	})
+
+	_, err := client.List(context.TODO(), applications.ListParams{Hydrate: true})
+	assert.ErrorContains(t, err, "APPLICATION_INVALID")
+}
+
+func TestListPaginatorHydrate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := applications.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications", func(header http.Header, query url.Values, body []byte) (int, string) {
+		switch query.Get("next_token") {
+		case "page_2":
+			return http.StatusOK, `{"code":"OK","applications":[{"id":"1"}],"next_token":"page_3"}`
+		case "page_3":
+			return http.StatusOK, `{"code":"OK","applications":[{"id":"2"}]}`
+		}
+
+		t.Errorf("unexpected next_token %q", query.Get("next_token"))
+		return http.StatusBadRequest, `{"errors":[]}`
+	})
+	for _, id := range []string{"1", "2"} {
+		testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/applications/"+id, func(header http.Header, query url.Values, body []byte) (int, string) {
+			return http.StatusOK, `{"code":"OK","application":{"id":"` + id + `","client_id":"client_` + id + `"}}`
+		})
+	}
+
+	paginator := client.ListPaginator(applications.ListParams{NextToken: "page_2", Hydrate: true})
+	var ids []string
+	for paginator.HasNext() {
+		page, err := paginator.Next(context.TODO())
+		require.NoError(t, err)
+		for _, application := range page {
+			assert.Equal(t, "client_"+application.ID, application.ClientID)
+			ids = append(ids, application.ID)
+		}
+	}
+
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/applications"))
+}
@@ -4,15 +4,24 @@ import "github.com/nxt-fwd/kinde-go/internal/enum"
 
 // https://kinde.com/api/docs/#kinde-management-api-applications
 type Application struct {
-	ID           string   `json:"id"`
-	Name         string   `json:"name"`
-	Type         Type     `json:"type"`
-	ClientID     string   `json:"client_id"`
-	ClientSecret string   `json:"client_secret"`
-	LoginURI     string   `json:"login_uri"`
-	HomepageURI  string   `json:"homepage_uri"`
-	LogoutURIs   []string `json:"logout_uris"`
-	RedirectURIs []string `json:"redirect_uris"`
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Type            Type     `json:"type"`
+	ClientID        string   `json:"client_id"`
+	ClientSecret    string   `json:"client_secret"`
+	LoginURI        string   `json:"login_uri"`
+	HomepageURI     string   `json:"homepage_uri"`
+	LogoutURIs      []string `json:"logout_uris"`
+	RedirectURIs    []string `json:"redirect_uris"`
+	LanguageKey     string   `json:"language_key,omitempty"`
+	HasCancelButton bool     `json:"has_cancel_button"`
+	ConnectionIDs   []string `json:"connection_ids,omitempty"`
+	// token lifetimes in seconds, see TokenSettings to update them
+	AccessTokenLifetime          int  `json:"access_token_lifetime"`
+	RefreshTokenLifetime         int  `json:"refresh_token_lifetime"`
+	IDTokenLifetime              int  `json:"id_token_lifetime"`
+	AuthenticatedSessionLifetime int  `json:"authenticated_session_lifetime"`
+	IsHasuraMappingEnabled       bool `json:"is_hasura_mapping_enabled"`
 }
 
 var _ enum.Enum[Type] = (*Type)(nil)
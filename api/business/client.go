@@ -0,0 +1,110 @@
+package business
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+type GetResponse struct {
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+	Business Business `json:"business"`
+}
+
+// https://kinde.com/api/docs/#get-business
+func (c *Client) Get(ctx context.Context) (*Business, error) {
+	endpoint := "/api/v1/business"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Business, nil
+}
+
+type UpdateParams struct {
+	Name                string `json:"business_name,omitempty"`
+	Email               string `json:"primary_email,omitempty"`
+	Phone               string `json:"primary_phone,omitempty"`
+	IndustryKey         string `json:"industry_key,omitempty"`
+	TimezoneID          string `json:"timezone_id,omitempty"`
+	PrivacyURL          string `json:"privacy_url,omitempty"`
+	TermsURL            string `json:"terms_url,omitempty"`
+	IsShowKindeBranding *bool  `json:"is_show_kinde_branding,omitempty"`
+	IsClickwrap         *bool  `json:"is_click_wrap,omitempty"`
+}
+
+// https://kinde.com/api/docs/#update-business
+func (c *Client) Update(ctx context.Context, params UpdateParams) (*Business, error) {
+	endpoint := "/api/v1/business"
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return nil, err
+	}
+
+	// Get the updated business
+	return c.Get(ctx)
+}
+
+type ListIndustriesResponse struct {
+	Code       string     `json:"code"`
+	Message    string     `json:"message"`
+	Industries []Industry `json:"industries"`
+}
+
+// https://kinde.com/api/docs/#get-industries
+func (c *Client) ListIndustries(ctx context.Context) ([]Industry, error) {
+	endpoint := "/api/v1/industries"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListIndustriesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Industries, nil
+}
+
+type ListTimezonesResponse struct {
+	Code      string     `json:"code"`
+	Message   string     `json:"message"`
+	Timezones []Timezone `json:"timezones"`
+}
+
+// https://kinde.com/api/docs/#get-timezones
+func (c *Client) ListTimezones(ctx context.Context) ([]Timezone, error) {
+	endpoint := "/api/v1/timezones"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListTimezonesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Timezones, nil
+}
@@ -0,0 +1,34 @@
+//go:build e2e
+// +build e2e
+
+package business_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/business"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE2EGet(t *testing.T) {
+	client := business.New(testutil.DefaultE2EClient(t))
+	res, err := client.Get(context.TODO())
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	t.Logf("got business: %+v\n", res)
+}
+
+func TestE2EReferenceData(t *testing.T) {
+	client := business.New(testutil.DefaultE2EClient(t))
+
+	industries, err := client.ListIndustries(context.TODO())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, industries)
+
+	timezones, err := client.ListTimezones(context.TODO())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, timezones)
+}
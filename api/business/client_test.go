@@ -0,0 +1,52 @@
+package business_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/business"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := business.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/business", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","business":{"code":"bus_1","name":"Acme","timezone":"Australia/Sydney"}}`
+	})
+	res, err := client.Get(context.TODO())
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "Acme", res.Name)
+}
+
+func TestUpdate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := business.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/business", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"business_name":"Acme","industry_key":"software"}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	_, _ = client.Update(context.TODO(), business.UpdateParams{Name: "Acme", IndustryKey: "software"})
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/business"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/business"))
+}
+
+func TestListIndustries(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := business.New(client.New(context.TODO(), nil))
+	_, _ = client.ListIndustries(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/industries"))
+}
+
+func TestListTimezones(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := business.New(client.New(context.TODO(), nil))
+	_, _ = client.ListTimezones(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/timezones"))
+}
@@ -0,0 +1,30 @@
+package business
+
+import "time"
+
+// https://kinde.com/api/docs/#kinde-management-api-business
+type Business struct {
+	Code             string    `json:"code"`
+	Name             string    `json:"name"`
+	Phone            string    `json:"phone,omitempty"`
+	Email            string    `json:"email,omitempty"`
+	Industry         string    `json:"industry,omitempty"`
+	Timezone         string    `json:"timezone,omitempty"`
+	PrivacyURL       string    `json:"privacy_url,omitempty"`
+	TermsURL         string    `json:"terms_url,omitempty"`
+	HasClickwrap     bool      `json:"has_clickwrap"`
+	HasKindeBranding bool      `json:"has_kinde_branding"`
+	CreatedOn        time.Time `json:"created_on,omitempty"`
+}
+
+// Industry is an industry reference value, Key is used in UpdateParams
+type Industry struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// Timezone is a timezone reference value, Key is used in UpdateParams
+type Timezone struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
@@ -22,6 +22,7 @@ type CreateParams struct {
 	DisplayName         string      `json:"display_name"`                   // Public facing name
 	Strategy            Strategy    `json:"strategy"`                       // Identity provider identifier
 	EnabledApplications []string    `json:"enabled_applications,omitempty"` // Client IDs of enabled applications
+	OrganizationCode    string      `json:"organization_code,omitempty"`    // Restricts the connection to a single organization
 	Options             interface{} `json:"options,omitempty"`              // Connection-specific options
 }
 
@@ -0,0 +1,127 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+// https://kinde.com/api/docs/#get-environment
+func (c *Client) Get(ctx context.Context) (*Environment, error) {
+	endpoint := "/api/v1/environment"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Environment, nil
+}
+
+// ListFeatureFlags gets the effective feature flag values for the environment
+//
+// https://kinde.com/api/docs/#list-environment-feature-flags
+func (c *Client) ListFeatureFlags(ctx context.Context) (featureflags.Values, error) {
+	endpoint := "/api/v1/environment/feature_flags"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response featureflags.ValuesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.FeatureFlags == nil {
+		response.FeatureFlags = featureflags.Values{}
+	}
+
+	return response.FeatureFlags, nil
+}
+
+// SetFeatureFlag overrides a feature flag value for the environment
+//
+// https://kinde.com/api/docs/#update-environment-feature-flag-override
+func (c *Client) SetFeatureFlag(ctx context.Context, key string, value featureflags.Value) error {
+	if value.IsZero() {
+		return fmt.Errorf("feature flag value is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/environment/feature_flags/%s", key)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, map[string]string{
+		"value": value.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverride resets a feature flag to its default value for
+// the environment
+//
+// https://kinde.com/api/docs/#delete-environment-feature-flag-override
+func (c *Client) DeleteFeatureFlagOverride(ctx context.Context, key string) error {
+	endpoint := fmt.Sprintf("/api/v1/environment/feature_flags/%s", key)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverrides resets every feature flag to its default value
+// for the environment
+//
+// https://kinde.com/api/docs/#delete-environment-feature-flag-overrides
+func (c *Client) DeleteFeatureFlagOverrides(ctx context.Context) error {
+	endpoint := "/api/v1/environment/feature_flags"
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package environments_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/environments"
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := environments.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/environment", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","environment":{"code":"production","name":"Production","is_default":true,"is_live":true,"kinde_domain":"acme.kinde.com"}}`
+	})
+	res, err := client.Get(context.TODO())
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "production", res.Code)
+	assert.True(t, res.IsLive)
+}
+
+func TestListFeatureFlags(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := environments.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/environment/feature_flags", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","feature_flags":{"dark_mode":{"type":"bool","value":true},"theme":{"type":"str","value":"pink"},"seats":{"type":"int","value":5}}}`
+	})
+
+	flags, err := client.ListFeatureFlags(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, flags, 3)
+
+	darkMode, err := flags["dark_mode"].Bool()
+	assert.NoError(t, err)
+	assert.True(t, darkMode)
+
+	theme, err := flags["theme"].Str()
+	assert.NoError(t, err)
+	assert.Equal(t, "pink", theme)
+
+	seats, err := flags["seats"].Int()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), seats)
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := environments.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/environment/feature_flags/dark_mode", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"value":"true"}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.SetFeatureFlag(context.TODO(), "dark_mode", featureflags.BoolValue(true))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/environment/feature_flags/dark_mode"))
+}
+
+func TestDeleteFeatureFlagOverride(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := environments.New(client.New(context.TODO(), nil))
+	_ = client.DeleteFeatureFlagOverride(context.TODO(), "dark_mode")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/environment/feature_flags/dark_mode"))
+}
+
+func TestDeleteFeatureFlagOverrides(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := environments.New(client.New(context.TODO(), nil))
+	_ = client.DeleteFeatureFlagOverrides(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/environment/feature_flags"))
+}
@@ -0,0 +1,28 @@
+package environments
+
+import "time"
+
+// https://kinde.com/api/docs/#kinde-management-api-environments
+type Environment struct {
+	Code               string    `json:"code"`
+	Name               string    `json:"name"`
+	IsDefault          bool      `json:"is_default"`
+	IsLive             bool      `json:"is_live"`
+	KindeDomain        string    `json:"kinde_domain"`
+	CustomDomain       *string   `json:"custom_domain,omitempty"`
+	HotjarSiteID       *string   `json:"hotjar_site_id,omitempty"`
+	GoogleAnalyticsTag *string   `json:"google_analytics_tag,omitempty"`
+	Logo               *string   `json:"logo,omitempty"`
+	LogoDark           *string   `json:"logo_dark,omitempty"`
+	FaviconSvg         *string   `json:"favicon_svg,omitempty"`
+	FaviconFallback    *string   `json:"favicon_fallback,omitempty"`
+	ThemeCode          *string   `json:"theme_code,omitempty"`
+	ColorScheme        string    `json:"color_scheme,omitempty"`
+	CreatedOn          time.Time `json:"created_on,omitempty"`
+}
+
+type GetResponse struct {
+	Code        string      `json:"code"`
+	Message     string      `json:"message"`
+	Environment Environment `json:"environment"`
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+type GetResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Event   Event  `json:"event"`
+}
+
+// Get fetches an event by ID, the returned event decodes the same way as the
+// events delivered to webhooks
+//
+// https://kinde.com/api/docs/#get-event
+func (c *Client) Get(ctx context.Context, id string) (*Event, error) {
+	endpoint := fmt.Sprintf("/api/v1/events/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Event, nil
+}
+
+// ListTypes lists the event types, see webhooks.Client.ListEventTypes
+func (c *Client) ListTypes(ctx context.Context) ([]webhooks.EventTypeInfo, error) {
+	return webhooks.New(c.Client).ListEventTypes(ctx)
+}
@@ -0,0 +1,42 @@
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/events"
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := events.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/events/event_1", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","event":{"type":"organization.created","source":"api","event_id":"event_1","timestamp":1700000000,"data":{"organization":{"code":"org_1","name":"Acme"}}}}`
+	})
+
+	event, err := client.Get(context.TODO(), "event_1")
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, webhooks.EventTypeOrganizationCreated, event.Type)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), event.Timestamp)
+
+	var data events.OrganizationData
+	require.NoError(t, event.DecodeData(&data))
+	assert.Equal(t, "org_1", data.Organization.Code)
+	assert.Equal(t, "Acme", data.Organization.Name)
+}
+
+func TestListTypes(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := events.New(client.New(context.TODO(), nil))
+	_, _ = client.ListTypes(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/event_types"))
+}
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/api/users"
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+)
+
+// Event is a kinde event as delivered by webhooks, Data holds the event type
+// specific payload and can be decoded with DecodeData
+type Event struct {
+	ID        string             `json:"event_id"`
+	Type      webhooks.EventType `json:"type"`
+	Source    string             `json:"source"`
+	Timestamp time.Time          `json:"timestamp"`
+	Data      json.RawMessage    `json:"data"`
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type alias Event
+	var raw struct {
+		alias
+		// webhooks send an RFC 3339 timestamp while the events api may return
+		// unix seconds
+		Timestamp json.RawMessage `json:"timestamp"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*e = Event(raw.alias)
+	if len(raw.Timestamp) == 0 || string(raw.Timestamp) == "null" {
+		return nil
+	}
+
+	var seconds int64
+	if err := json.Unmarshal(raw.Timestamp, &seconds); err == nil {
+		e.Timestamp = time.Unix(seconds, 0).UTC()
+		return nil
+	}
+
+	if err := json.Unmarshal(raw.Timestamp, &e.Timestamp); err != nil {
+		return fmt.Errorf("failed to parse event timestamp: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeData decodes the event payload into target, typically one of the
+// *Data types in this package
+func (e Event) DecodeData(target any) error {
+	if len(e.Data) == 0 {
+		return fmt.Errorf("event %s has no data", e.ID)
+	}
+
+	if err := json.Unmarshal(e.Data, target); err != nil {
+		return fmt.Errorf("failed to decode %s event data: %w", e.Type, err)
+	}
+
+	return nil
+}
+
+// UserOrganization is a user's membership as included in user events
+type UserOrganization struct {
+	Code        string   `json:"code"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
+// UserData is the payload of user.created, user.updated, user.deleted and
+// user.authenticated events
+type UserData struct {
+	User          users.User
+	Username      string
+	Phone         string
+	Organizations []UserOrganization
+}
+
+func (d *UserData) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		User struct {
+			users.User
+			// user events name the preferred email "email"
+			Email         string             `json:"email"`
+			Username      *string            `json:"username"`
+			Phone         *string            `json:"phone"`
+			Organizations []UserOrganization `json:"organizations"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	d.User = raw.User.User
+	if d.User.PreferredEmail == "" {
+		d.User.PreferredEmail = raw.User.Email
+	}
+	if raw.User.Username != nil {
+		d.Username = *raw.User.Username
+	}
+	if raw.User.Phone != nil {
+		d.Phone = *raw.User.Phone
+	}
+	d.Organizations = raw.User.Organizations
+
+	return nil
+}
+
+// OrganizationData is the payload of organization.created,
+// organization.updated and organization.deleted events
+type OrganizationData struct {
+	Organization organizations.Organization `json:"organization"`
+}
+
+// OrganizationUserData is the payload of organization.user_added and
+// organization.user_removed events
+type OrganizationUserData struct {
+	Organization organizations.Organization `json:"organization"`
+	User         users.User                 `json:"user"`
+}
+
+// RoleData is the payload of role.created, role.updated and role.deleted events
+type RoleData struct {
+	Role organizations.Role `json:"role"`
+}
+
+// RoleAssignedData is the payload of role.assigned events
+type RoleAssignedData struct {
+	Role         organizations.Role         `json:"role"`
+	User         users.User                 `json:"user"`
+	Organization organizations.Organization `json:"organization"`
+}
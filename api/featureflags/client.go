@@ -0,0 +1,142 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+// CreateParams describes a new feature flag, the flag type is taken from
+// DefaultValue
+type CreateParams struct {
+	Key                string
+	Name               string
+	Description        string
+	AllowOverrideLevel AllowOverrideLevel
+	DefaultValue       Value
+}
+
+func (p CreateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key                string             `json:"key"`
+		Name               string             `json:"name"`
+		Description        string             `json:"description,omitempty"`
+		Type               Type               `json:"type"`
+		AllowOverrideLevel AllowOverrideLevel `json:"allow_override_level,omitempty"`
+		DefaultValue       Value              `json:"default_value"`
+	}{
+		Key:                p.Key,
+		Name:               p.Name,
+		Description:        p.Description,
+		Type:               p.DefaultValue.Type(),
+		AllowOverrideLevel: p.AllowOverrideLevel,
+		DefaultValue:       p.DefaultValue,
+	})
+}
+
+func validateDefinition(level AllowOverrideLevel, defaultValue Value) error {
+	if defaultValue.IsZero() {
+		return fmt.Errorf("feature flag default value is required")
+	}
+
+	if level != "" {
+		if err := level.Valid(); err != nil {
+			return fmt.Errorf("invalid allow override level: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#create-a-new-feature-flag
+func (c *Client) Create(ctx context.Context, params CreateParams) error {
+	if err := validateDefinition(params.AllowOverrideLevel, params.DefaultValue); err != nil {
+		return err
+	}
+
+	endpoint := "/api/v1/feature_flags"
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateParams replaces the definition of an existing feature flag.
+// AllowOverrideLevel and DefaultValue are required, an empty Name or
+// Description is not sent.
+type UpdateParams struct {
+	Name               string
+	Description        string
+	AllowOverrideLevel AllowOverrideLevel
+	DefaultValue       Value
+}
+
+// https://kinde.com/api/docs/#replace-a-feature-flag
+//
+// note: the api takes the definition as query parameters
+func (c *Client) Update(ctx context.Context, key string, params UpdateParams) error {
+	// the definition is replaced, an unset level would silently clear it
+	if params.AllowOverrideLevel == "" {
+		return fmt.Errorf("feature flag allow override level is required")
+	}
+
+	if err := validateDefinition(params.AllowOverrideLevel, params.DefaultValue); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if params.Name != "" {
+		query.Set("name", params.Name)
+	}
+	if params.Description != "" {
+		query.Set("description", params.Description)
+	}
+	query.Set("type", string(params.DefaultValue.Type()))
+	query.Set("allow_override_level", string(params.AllowOverrideLevel))
+	query.Set("default_value", params.DefaultValue.String())
+
+	endpoint := fmt.Sprintf("/api/v1/feature_flags/%s", key)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, query, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#delete-a-feature-flag
+func (c *Client) Delete(ctx context.Context, key string) error {
+	endpoint := fmt.Sprintf("/api/v1/feature_flags/%s", key)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
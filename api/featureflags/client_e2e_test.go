@@ -0,0 +1,44 @@
+//go:build e2e
+// +build e2e
+
+package featureflags_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestE2ECreateUpdateDelete(t *testing.T) {
+	client := featureflags.New(testutil.DefaultE2EClient(t))
+	tempID := fmt.Sprintf("test_%d", time.Now().UnixMilli())
+
+	err := client.Create(context.TODO(), featureflags.CreateParams{
+		Key:                tempID,
+		Name:               tempID,
+		AllowOverrideLevel: featureflags.AllowOverrideLevelUser,
+		DefaultValue:       featureflags.BoolValue(false),
+	})
+	assert.NoError(t, err)
+
+	t.Logf("created test feature flag: %s\n", tempID)
+
+	err = client.Update(context.TODO(), tempID, featureflags.UpdateParams{
+		Name:               tempID + "2",
+		AllowOverrideLevel: featureflags.AllowOverrideLevelUser,
+		DefaultValue:       featureflags.BoolValue(true),
+	})
+	assert.NoError(t, err)
+
+	t.Logf("updated test feature flag: %s\n", tempID)
+
+	err = client.Delete(context.TODO(), tempID)
+	assert.NoError(t, err)
+
+	t.Logf("deleted test feature flag: %s\n", tempID)
+}
@@ -0,0 +1,123 @@
+package featureflags_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := featureflags.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/feature_flags", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"key":"theme","name":"Theme","type":"str","allow_override_level":"org","default_value":"dark"}`, string(body))
+		return http.StatusCreated, `{"code":"FEATURE_FLAG_CREATED"}`
+	})
+	err := client.Create(context.TODO(), featureflags.CreateParams{
+		Key:                "theme",
+		Name:               "Theme",
+		AllowOverrideLevel: featureflags.AllowOverrideLevelOrganization,
+		DefaultValue:       featureflags.StringValue("dark"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/feature_flags"))
+}
+
+func TestCreateMissingDefaultValue(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := featureflags.New(client.New(context.TODO(), nil))
+	err := client.Create(context.TODO(), featureflags.CreateParams{Key: "theme", Name: "Theme"})
+	assert.Error(t, err)
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodPost, "/api/v1/feature_flags"))
+}
+
+func TestUpdate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := featureflags.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/feature_flags/seats", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "Seats", query.Get("name"))
+		assert.Equal(t, "int", query.Get("type"))
+		assert.Equal(t, "env", query.Get("allow_override_level"))
+		assert.Equal(t, "5", query.Get("default_value"))
+		assert.False(t, query.Has("description"))
+		return http.StatusOK, `{"code":"FEATURE_FLAG_UPDATED"}`
+	})
+	err := client.Update(context.TODO(), "seats", featureflags.UpdateParams{
+		Name:               "Seats",
+		AllowOverrideLevel: featureflags.AllowOverrideLevelEnvironment,
+		DefaultValue:       featureflags.IntValue(5),
+	})
+	assert.NoError(t, err)
+
+	err = client.Update(context.TODO(), "seats", featureflags.UpdateParams{
+		Name:         "Seats",
+		DefaultValue: featureflags.IntValue(5),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/feature_flags/seats"))
+}
+
+func TestDelete(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := featureflags.New(client.New(context.TODO(), nil))
+	_ = client.Delete(context.TODO(), "seats")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/feature_flags/seats"))
+}
+
+func TestValue(t *testing.T) {
+	b, err := featureflags.BoolValue(true).Bool()
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	_, err = featureflags.BoolValue(true).Int()
+	assert.Error(t, err)
+
+	value, err := featureflags.JSONValue(map[string]int{"limit": 3})
+	require.NoError(t, err)
+	assert.Equal(t, `{"limit":3}`, value.String())
+
+	var target map[string]int
+	require.NoError(t, value.JSON(&target))
+	assert.Equal(t, 3, target["limit"])
+
+	value, err = featureflags.ParseValue(featureflags.TypeInt, "three")
+	assert.Error(t, err)
+	assert.True(t, value.IsZero())
+
+	value, err = featureflags.ParseValue(featureflags.TypeBool, "maybe")
+	assert.Error(t, err)
+	assert.True(t, value.IsZero())
+}
+
+func TestValuesUnmarshalMalformed(t *testing.T) {
+	var values featureflags.Values
+	err := json.Unmarshal([]byte(`{
+		"theme":{"type":"str","value":"dark"},
+		"limit":{"type":"int","value":"three"},
+		"beta":{"type":"float","value":1.5}
+	}`), &values)
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+
+	theme, err := values["theme"].Str()
+	assert.NoError(t, err)
+	assert.Equal(t, "dark", theme)
+	assert.NoError(t, values["theme"].Err())
+
+	_, err = values["limit"].Int()
+	assert.Error(t, err)
+	assert.Error(t, values["limit"].Err())
+	assert.Equal(t, "three", values["limit"].String())
+
+	assert.Error(t, values["beta"].Err())
+	assert.Equal(t, featureflags.Type("float"), values["beta"].Type())
+	assert.Equal(t, "1.5", values["beta"].String())
+}
@@ -0,0 +1,214 @@
+package featureflags
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/nxt-fwd/kinde-go/internal/enum"
+)
+
+var _ enum.Enum[Type] = (*Type)(nil)
+
+type Type string
+
+const (
+	TypeBool   Type = "bool"
+	TypeString Type = "str"
+	TypeInt    Type = "int"
+	TypeJSON   Type = "json"
+)
+
+func (t Type) Options() []Type {
+	return []Type{
+		TypeBool,
+		TypeString,
+		TypeInt,
+		TypeJSON,
+	}
+}
+
+func (t Type) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+var _ enum.Enum[AllowOverrideLevel] = (*AllowOverrideLevel)(nil)
+
+type AllowOverrideLevel string
+
+const (
+	AllowOverrideLevelEnvironment  AllowOverrideLevel = "env"
+	AllowOverrideLevelOrganization AllowOverrideLevel = "org"
+	AllowOverrideLevelUser         AllowOverrideLevel = "usr"
+)
+
+func (t AllowOverrideLevel) Options() []AllowOverrideLevel {
+	return []AllowOverrideLevel{
+		AllowOverrideLevelEnvironment,
+		AllowOverrideLevelOrganization,
+		AllowOverrideLevelUser,
+	}
+}
+
+func (t AllowOverrideLevel) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// Value is a typed feature flag value. The kinde api accepts every value as a
+// string, Value keeps track of the flag type so callers never deal with the
+// raw encoding.
+type Value struct {
+	typ Type
+	raw string
+	// err is set on values decoded from a malformed flag
+	err error
+}
+
+func BoolValue(v bool) Value {
+	return Value{typ: TypeBool, raw: strconv.FormatBool(v)}
+}
+
+func StringValue(v string) Value {
+	return Value{typ: TypeString, raw: v}
+}
+
+func IntValue(v int64) Value {
+	return Value{typ: TypeInt, raw: strconv.FormatInt(v, 10)}
+}
+
+// JSONValue encodes v as a json feature flag value
+func JSONValue(v any) (Value, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return Value{}, fmt.Errorf("failed to marshal json value: %w", err)
+	}
+
+	return Value{typ: TypeJSON, raw: string(raw)}, nil
+}
+
+// ParseValue parses the string encoding of a value of type t, a zero Value is
+// returned on error
+func ParseValue(t Type, raw string) (Value, error) {
+	if err := t.Valid(); err != nil {
+		return Value{}, err
+	}
+
+	value := Value{typ: t, raw: raw}
+	switch t {
+	case TypeBool:
+		if _, err := value.Bool(); err != nil {
+			return Value{}, fmt.Errorf("invalid bool value %q: %w", raw, err)
+		}
+	case TypeInt:
+		if _, err := value.Int(); err != nil {
+			return Value{}, fmt.Errorf("invalid int value %q: %w", raw, err)
+		}
+	case TypeJSON:
+		if !json.Valid([]byte(raw)) {
+			return Value{}, fmt.Errorf("invalid json value %q", raw)
+		}
+	}
+
+	return value, nil
+}
+
+func (v Value) Type() Type {
+	return v.typ
+}
+
+// Err returns the parse error of a value decoded from a malformed flag, such
+// as a flag of an unknown type or with a value not matching its type
+func (v Value) Err() error {
+	return v.err
+}
+
+// IsZero reports whether v was never assigned
+func (v Value) IsZero() bool {
+	return v.typ == ""
+}
+
+// String returns the value as encoded for the kinde api
+func (v Value) String() string {
+	return v.raw
+}
+
+func (v Value) Bool() (bool, error) {
+	if v.typ != TypeBool {
+		return false, fmt.Errorf("feature flag value is of type %s, not %s", v.typ, TypeBool)
+	}
+
+	return strconv.ParseBool(v.raw)
+}
+
+func (v Value) Str() (string, error) {
+	if v.typ != TypeString {
+		return "", fmt.Errorf("feature flag value is of type %s, not %s", v.typ, TypeString)
+	}
+
+	return v.raw, nil
+}
+
+func (v Value) Int() (int64, error) {
+	if v.typ != TypeInt {
+		return 0, fmt.Errorf("feature flag value is of type %s, not %s", v.typ, TypeInt)
+	}
+
+	return strconv.ParseInt(v.raw, 10, 64)
+}
+
+// JSON decodes a json value into target
+func (v Value) JSON(target any) error {
+	if v.typ != TypeJSON {
+		return fmt.Errorf("feature flag value is of type %s, not %s", v.typ, TypeJSON)
+	}
+
+	return json.Unmarshal([]byte(v.raw), target)
+}
+
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.raw)
+}
+
+// Values maps feature flag keys to their effective values. Flags of an unknown
+// type or with a value not matching their type are kept as raw values, String
+// returns them as sent, Err returns the parse error and the typed accessors
+// report an error.
+type Values map[string]Value
+
+func (v *Values) UnmarshalJSON(data []byte) error {
+	var raw map[string]struct {
+		Type  Type            `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	values := make(Values, len(raw))
+	for key, flag := range raw {
+		// the api returns values in their json form, strings are unquoted so
+		// they match the encoding used when setting values
+		encoded := string(flag.Value)
+		var str string
+		if err := json.Unmarshal(flag.Value, &str); err == nil {
+			encoded = str
+		}
+
+		value, err := ParseValue(flag.Type, encoded)
+		if err != nil {
+			// one malformed flag must not hide every other flag
+			value = Value{typ: flag.Type, raw: encoded, err: fmt.Errorf("feature flag %s: %w", key, err)}
+		}
+
+		values[key] = value
+	}
+
+	*v = values
+	return nil
+}
+
+type ValuesResponse struct {
+	Code         string `json:"code"`
+	Message      string `json:"message"`
+	FeatureFlags Values `json:"feature_flags"`
+}
@@ -2,6 +2,7 @@ package identities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/nxt-fwd/kinde-go/internal/client"
 )
 
+var ErrIdentityNotFound = errors.New("identity not found")
+
 type Client struct {
 	c client.Client
 }
@@ -85,3 +88,55 @@ func (c *Client) Delete(ctx context.Context, identityID string) error {
 
 	return nil
 }
+
+// find returns the first identity of a user accepted by match, walking every
+// page of identities
+func (c *Client) find(ctx context.Context, userID string, match func(users.Identity) bool) (*users.Identity, error) {
+	paginator, err := users.New(c.c).ListIdentitiesPaginator(userID, users.ListIdentitiesParams{})
+	if err != nil {
+		return nil, err
+	}
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, identity := range page {
+			if match(identity) {
+				return &identity, nil
+			}
+		}
+	}
+
+	return nil, ErrIdentityNotFound
+}
+
+// FindIdentity finds the identity of a user with the given type and value,
+// see users.Identity.Matches for how values are compared
+func (c *Client) FindIdentity(ctx context.Context, userID string, identityType users.IdentityType, value string) (*users.Identity, error) {
+	if err := identityType.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid identity type: %w", err)
+	}
+
+	identity, err := c.find(ctx, userID, func(identity users.Identity) bool {
+		return identity.Matches(identityType, value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s identity %s: %w", identityType, value, err)
+	}
+
+	return identity, nil
+}
+
+// PrimaryEmail finds the primary email identity of a user
+func (c *Client) PrimaryEmail(ctx context.Context, userID string) (*users.Identity, error) {
+	identity, err := c.find(ctx, userID, func(identity users.Identity) bool {
+		return identity.Type == users.IdentityTypeEmail && identity.IsPrimary
+	})
+	if err != nil {
+		return nil, fmt.Errorf("primary email: %w", err)
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,68 @@
+package identities_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/identities"
+	"github.com/nxt-fwd/kinde-go/api/users"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func handleIdentities(t *testing.T, testServer *testutil.TestServer) {
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/identities", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("starting_after") == "" {
+			return http.StatusOK, `{"code":"OK","has_more":true,"identities":[` +
+				`{"id":"id_1","type":"email","name":"jane@example.com"},` +
+				`{"id":"id_2","type":"phone","name":"+61 412 345 678"}]}`
+		}
+
+		assert.Equal(t, "id_2", query.Get("starting_after"))
+		return http.StatusOK, `{"code":"OK","has_more":false,"identities":[` +
+			`{"id":"id_3","type":"email","name":"Jane.Doe@example.com","is_primary":true},` +
+			`{"id":"id_4","type":"username","name":"jane"}]}`
+	})
+}
+
+func TestFindIdentity(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := identities.New(client.New(context.TODO(), nil))
+	handleIdentities(t, testServer)
+
+	identity, err := client.FindIdentity(context.TODO(), "kp_1", users.IdentityTypePhone, "+61412345678")
+	assert.NoError(t, err)
+	require.NotNil(t, identity)
+	assert.Equal(t, "id_2", identity.ID)
+
+	identity, err = client.FindIdentity(context.TODO(), "kp_1", users.IdentityTypeEmail, "jane.doe@example.com")
+	assert.NoError(t, err)
+	require.NotNil(t, identity)
+	assert.Equal(t, "id_3", identity.ID)
+
+	_, err = client.FindIdentity(context.TODO(), "kp_1", users.IdentityTypeUsername, "john")
+	assert.ErrorIs(t, err, identities.ErrIdentityNotFound)
+
+	_, err = client.FindIdentity(context.TODO(), "kp_1", "fax", "123")
+	assert.Error(t, err)
+	assert.Equal(t, 5, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/identities"))
+}
+
+func TestPrimaryEmail(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := identities.New(client.New(context.TODO(), nil))
+	handleIdentities(t, testServer)
+
+	identity, err := client.PrimaryEmail(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	require.NotNil(t, identity)
+	assert.Equal(t, "id_3", identity.ID)
+
+	email, ok := identity.Email()
+	assert.True(t, ok)
+	assert.Equal(t, "Jane.Doe@example.com", email)
+}
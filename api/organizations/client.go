@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/nxt-fwd/kinde-go/api/connections"
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/api/properties"
 	"github.com/nxt-fwd/kinde-go/internal/client"
 )
 
@@ -104,6 +108,125 @@ func (c *Client) Delete(ctx context.Context, code string) error {
 	return nil
 }
 
+// DeleteHandle removes the handle of an organization
+func (c *Client) DeleteHandle(ctx context.Context, code string) error {
+	endpoint := fmt.Sprintf("/api/v1/organization/%s/handle", code)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSessionSettings gets the session settings of an organization
+func (c *Client) GetSessionSettings(ctx context.Context, code string) (*SessionSettings, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/sessions", code)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		SessionSettings
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.SessionSettings, nil
+}
+
+// UpdateSessionSettings updates the session settings of an organization
+func (c *Client) UpdateSessionSettings(ctx context.Context, code string, params SessionSettings) (*SessionSettings, error) {
+	if params.SSOSessionPersistenceMode != "" {
+		if err := params.SSOSessionPersistenceMode.Valid(); err != nil {
+			return nil, fmt.Errorf("invalid sso session persistence mode: %w", err)
+		}
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/sessions", code)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Get the updated session settings
+	return c.GetSessionSettings(ctx, code)
+}
+
+// GetMFASettings gets the mfa factors enabled for an organization
+func (c *Client) GetMFASettings(ctx context.Context, code string) (*MFASettings, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/mfa", code)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		MFASettings
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Initialize empty slice if no factors are returned
+	if response.EnabledFactors == nil {
+		response.EnabledFactors = make([]MFAFactor, 0)
+	}
+
+	return &response.MFASettings, nil
+}
+
+// UpdateMFASettings replaces the mfa factors enabled for an organization
+func (c *Client) UpdateMFASettings(ctx context.Context, code string, params MFASettings) error {
+	for _, factor := range params.EnabledFactors {
+		if err := factor.Valid(); err != nil {
+			return fmt.Errorf("invalid mfa factor: %w", err)
+		}
+	}
+
+	if params.EnabledFactors == nil {
+		params.EnabledFactors = make([]MFAFactor, 0)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/mfa", code)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddUsers adds users to an organization with specified roles and permissions
 func (c *Client) AddUsers(ctx context.Context, code string, params AddUsersParams) error {
 	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", code)
@@ -120,8 +243,135 @@ func (c *Client) AddUsers(ctx context.Context, code string, params AddUsersParam
 	return nil
 }
 
+func (p ListUsersParams) query() (url.Values, error) {
+	query := url.Values{}
+	if p.Sort != "" {
+		if err := p.Sort.Valid(); err != nil {
+			return nil, fmt.Errorf("invalid sort: %w", err)
+		}
+		query.Set("sort", string(p.Sort))
+	}
+	if p.PageSize > 0 {
+		query.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+	if p.NextToken != "" {
+		query.Set("next_token", p.NextToken)
+	}
+	if len(p.Roles) > 0 {
+		query.Set("roles", strings.Join(p.Roles, ","))
+	}
+	if len(p.Permissions) > 0 {
+		query.Set("permissions", strings.Join(p.Permissions, ","))
+	}
+	if p.UserID != "" {
+		query.Set("user_id", p.UserID)
+	}
+
+	return query, nil
+}
+
+// ListUsers lists a single page of organization members
+func (c *Client) ListUsers(ctx context.Context, code string, params ListUsersParams) ([]User, error) {
+	query, err := params.query()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", code)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListUsersResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Initialize empty slice if no users are returned
+	if response.Users == nil {
+		response.Users = make([]User, 0)
+	}
+
+	return response.Users, nil
+}
+
+// ListUsersPaginator returns a paginator iterating over every page of
+// organization members
+func (c *Client) ListUsersPaginator(code string, params ListUsersParams) (*client.Paginator[User, ListUsersResponse], error) {
+	query, err := params.query()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := client.PaginatorOptions{
+		PageSize: params.PageSize,
+		Query:    query,
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", code)
+	return client.NewPaginator[User, ListUsersResponse](c, endpoint, opts), nil
+}
+
+// RemoveUser removes a user from an organization
+func (c *Client) RemoveUser(ctx context.Context, code string, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s", code, userID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// afterMutation applies the mutation options once a role or permission of a
+// user has changed, errors wrap ErrRefreshFailed as the mutation is already
+// applied
+func (c *Client) afterMutation(ctx context.Context, userID string, opts []MutationOption) error {
+	var options mutationOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.refreshClaims {
+		if err := c.refreshClaims(ctx, userID); err != nil {
+			return fmt.Errorf("%w: %w", ErrRefreshFailed, err)
+		}
+	}
+
+	return nil
+}
+
+// refreshClaims mirrors users.Client.RefreshClaims, the users package depends
+// on organizations so it cannot be imported here
+func (c *Client) refreshClaims(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/refresh_claims", userID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddUserRole adds a role to a user in an organization
-func (c *Client) AddUserRole(ctx context.Context, orgCode string, userID string, roleID string) error {
+func (c *Client) AddUserRole(ctx context.Context, orgCode string, userID string, roleID string, opts ...MutationOption) error {
 	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s/roles", orgCode, userID)
 	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, map[string]string{
 		"role_id": roleID,
@@ -138,7 +388,7 @@ func (c *Client) AddUserRole(ctx context.Context, orgCode string, userID string,
 		return err
 	}
 
-	return nil
+	return c.afterMutation(ctx, userID, opts)
 }
 
 // GetUserRoles gets all roles assigned to a user in an organization
@@ -167,7 +417,7 @@ func (c *Client) GetUserRoles(ctx context.Context, orgCode string, userID string
 }
 
 // RemoveUserRole removes a role from a user in an organization
-func (c *Client) RemoveUserRole(ctx context.Context, orgCode string, userID string, roleID string) error {
+func (c *Client) RemoveUserRole(ctx context.Context, orgCode string, userID string, roleID string, opts ...MutationOption) error {
 	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s/roles/%s", orgCode, userID, roleID)
 	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
 	if err != nil {
@@ -182,5 +432,371 @@ func (c *Client) RemoveUserRole(ctx context.Context, orgCode string, userID stri
 		return err
 	}
 
+	return c.afterMutation(ctx, userID, opts)
+}
+
+// AddUserPermission adds a permission to a user in an organization
+func (c *Client) AddUserPermission(ctx context.Context, orgCode string, userID string, permissionID string, opts ...MutationOption) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s/permissions", orgCode, userID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, map[string]string{
+		"permission_id": permissionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return c.afterMutation(ctx, userID, opts)
+}
+
+// GetUserPermissions gets all permissions a user has in an organization,
+// including the roles granting them
+func (c *Client) GetUserPermissions(ctx context.Context, orgCode string, userID string) ([]Permission, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s/permissions", orgCode, userID)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code        string       `json:"code"`
+		Message     string       `json:"message"`
+		Permissions []Permission `json:"permissions"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Initialize empty slice if no permissions are returned
+	if response.Permissions == nil {
+		response.Permissions = make([]Permission, 0)
+	}
+
+	return response.Permissions, nil
+}
+
+// RemoveUserPermission removes a permission from a user in an organization
+func (c *Client) RemoveUserPermission(ctx context.Context, orgCode string, userID string, permissionID string, opts ...MutationOption) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users/%s/permissions/%s", orgCode, userID, permissionID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return c.afterMutation(ctx, userID, opts)
+}
+
+func (c *Client) uploadImage(ctx context.Context, code string, endpoint string, field string, image Image) (*Organization, error) {
+	if image.Content == nil {
+		return nil, fmt.Errorf("image content is required")
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, &client.MultipartBody{
+		Files: []client.MultipartFile{{
+			FieldName:   field,
+			FileName:    image.FileName,
+			ContentType: image.ContentType,
+			Content:     image.Content,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Get the organization with the new image urls
+	return c.Get(ctx, code)
+}
+
+func (c *Client) deleteImage(ctx context.Context, endpoint string) error {
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UploadLogo uploads a light or dark logo for an organization and returns the
+// organization with the new logo url
+func (c *Client) UploadLogo(ctx context.Context, code string, logoType LogoType, image Image) (*Organization, error) {
+	if err := logoType.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid logo type: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/logos/%s", code, logoType)
+	return c.uploadImage(ctx, code, endpoint, "logo", image)
+}
+
+// DeleteLogo deletes the light or dark logo of an organization
+func (c *Client) DeleteLogo(ctx context.Context, code string, logoType LogoType) error {
+	if err := logoType.Valid(); err != nil {
+		return fmt.Errorf("invalid logo type: %w", err)
+	}
+
+	return c.deleteImage(ctx, fmt.Sprintf("/api/v1/organizations/%s/logos/%s", code, logoType))
+}
+
+// UploadFavicon uploads an svg or fallback favicon for an organization and
+// returns the organization with the new favicon url
+func (c *Client) UploadFavicon(ctx context.Context, code string, faviconType FaviconType, image Image) (*Organization, error) {
+	if err := faviconType.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid favicon type: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/favicons/%s", code, faviconType)
+	return c.uploadImage(ctx, code, endpoint, "favicon", image)
+}
+
+// DeleteFavicon deletes the svg or fallback favicon of an organization
+func (c *Client) DeleteFavicon(ctx context.Context, code string, faviconType FaviconType) error {
+	if err := faviconType.Valid(); err != nil {
+		return fmt.Errorf("invalid favicon type: %w", err)
+	}
+
+	return c.deleteImage(ctx, fmt.Sprintf("/api/v1/organizations/%s/favicons/%s", code, faviconType))
+}
+
+// GetConnections retrieves all connections enabled for an organization
+func (c *Client) GetConnections(ctx context.Context, code string) ([]connections.Connection, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections", code)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code        string                   `json:"code"`
+		Message     string                   `json:"message"`
+		Connections []connections.Connection `json:"connections"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Initialize empty slice if no connections are returned
+	if response.Connections == nil {
+		response.Connections = make([]connections.Connection, 0)
+	}
+
+	return response.Connections, nil
+}
+
+// EnableConnection enables a connection for an organization, allowing its
+// members to authenticate with it
+func (c *Client) EnableConnection(ctx context.Context, code string, connectionID string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections/%s", code, connectionID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisableConnection disables a connection for an organization
+func (c *Client) DisableConnection(ctx context.Context, code string, connectionID string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections/%s", code, connectionID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListFeatureFlags gets the effective feature flag values for an organization
+func (c *Client) ListFeatureFlags(ctx context.Context, orgCode string) (featureflags.Values, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/feature_flags", orgCode)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response featureflags.ValuesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	// Initialize empty map if no feature flags are returned
+	if response.FeatureFlags == nil {
+		response.FeatureFlags = featureflags.Values{}
+	}
+
+	return response.FeatureFlags, nil
+}
+
+// SetFeatureFlag overrides a feature flag value for an organization
+func (c *Client) SetFeatureFlag(ctx context.Context, orgCode string, key string, value featureflags.Value) error {
+	if value.IsZero() {
+		return fmt.Errorf("feature flag value is required")
+	}
+
+	query := url.Values{}
+	query.Set("value", value.String())
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/feature_flags/%s", orgCode, key)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, query, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverride resets a feature flag to its default value for an
+// organization
+func (c *Client) DeleteFeatureFlagOverride(ctx context.Context, orgCode string, key string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/feature_flags/%s", orgCode, key)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverrides resets every feature flag to its default value for
+// an organization
+func (c *Client) DeleteFeatureFlagOverrides(ctx context.Context, orgCode string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/feature_flags", orgCode)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetProperties gets all property values set on an organization, following every
+// page
+func (c *Client) GetProperties(ctx context.Context, orgCode string) (properties.Values, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/properties", orgCode)
+	paginator := client.NewPaginator[properties.Value, properties.ValuesResponse](c, endpoint, client.PaginatorOptions{})
+
+	values := make(properties.Values, 0)
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, page...)
+	}
+
+	return values, nil
+}
+
+// SetProperty sets a single property value on an organization
+func (c *Client) SetProperty(ctx context.Context, orgCode string, key string, value string) error {
+	query := url.Values{}
+	query.Set("value", value)
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/properties/%s", orgCode, key)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, query, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProperties sets many property values on an organization in one call, values
+// can be built from a tagged struct with properties.Marshal
+func (c *Client) UpdateProperties(ctx context.Context, orgCode string, values map[string]string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/properties", orgCode)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, properties.UpdateValuesParams{
+		Properties: values,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
 	return nil
 }
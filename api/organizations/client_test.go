@@ -0,0 +1,291 @@
+package organizations_test
+
+import (
+	"bytes"
+	"context"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFeatureFlags(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/feature_flags", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","feature_flags":{"theme":{"type":"str","value":"pink"},"beta":{"type":"bool","value":true},"seats":{"type":"int","value":10}}}`
+	})
+
+	flags, err := client.ListFeatureFlags(context.TODO(), "org_1")
+	assert.NoError(t, err)
+	require.Len(t, flags, 3)
+
+	theme, err := flags["theme"].Str()
+	assert.NoError(t, err)
+	assert.Equal(t, "pink", theme)
+
+	beta, err := flags["beta"].Bool()
+	assert.NoError(t, err)
+	assert.True(t, beta)
+
+	seats, err := flags["seats"].Int()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), seats)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/organizations/org_1/feature_flags"))
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/organizations/org_1/feature_flags/theme", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "pink", query.Get("value"))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.SetFeatureFlag(context.TODO(), "org_1", "theme", featureflags.StringValue("pink"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/organizations/org_1/feature_flags/theme"))
+}
+
+func TestDeleteFeatureFlagOverrides(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	_ = client.DeleteFeatureFlagOverride(context.TODO(), "org_1", "theme")
+	_ = client.DeleteFeatureFlagOverrides(context.TODO(), "org_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/feature_flags/theme"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/feature_flags"))
+}
+
+func TestGetProperties(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("next_token") == "" {
+			return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_1","key":"crm_id","value":"crm_1"}],"next_token":"page_2"}`
+		}
+
+		assert.Equal(t, "page_2", query.Get("next_token"))
+		return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_2","key":"plan_tier","value":"gold"}]}`
+	})
+	values, err := client.GetProperties(context.TODO(), "org_1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"crm_id": "crm_1", "plan_tier": "gold"}, values.Map())
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/organizations/org_1/properties"))
+}
+
+func TestSetProperty(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/organizations/org_1/properties/crm_id", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "crm_1", query.Get("value"))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.SetProperty(context.TODO(), "org_1", "crm_id", "crm_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/organizations/org_1/properties/crm_id"))
+}
+
+func TestListUsers(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/users", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "email_asc", query.Get("sort"))
+		assert.Equal(t, "admin,billing", query.Get("roles"))
+		assert.Equal(t, "read:invoices", query.Get("permissions"))
+		return http.StatusOK, `{"code":"OK","organization_users":[{"id":"kp_1","email":"jane@example.com","roles":["admin"]}]}`
+	})
+	res, err := client.ListUsers(context.TODO(), "org_1", organizations.ListUsersParams{
+		Sort:        organizations.ListUsersSortEmailAsc,
+		Roles:       []string{"admin", "billing"},
+		Permissions: []string{"read:invoices"},
+	})
+	assert.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, []string{"admin"}, res[0].Roles)
+}
+
+func TestListUsersPaginator(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/users", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "admin", query.Get("roles"))
+		if query.Get("next_token") == "" {
+			return http.StatusOK, `{"code":"OK","organization_users":[{"id":"kp_1"}],"next_token":"token"}`
+		}
+		return http.StatusOK, `{"code":"OK","organization_users":[{"id":"kp_2"}]}`
+	})
+
+	paginator, err := client.ListUsersPaginator("org_1", organizations.ListUsersParams{Roles: []string{"admin"}})
+	require.NoError(t, err)
+
+	var res []organizations.User
+	for paginator.HasNext() {
+		page, err := paginator.Next(context.TODO())
+		require.NoError(t, err)
+		res = append(res, page...)
+	}
+	assert.Len(t, res, 2)
+}
+
+func TestRemoveUser(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	_ = client.RemoveUser(context.TODO(), "org_1", "kp_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/users/kp_1"))
+}
+
+func TestUserPermissions(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/organizations/org_1/users/kp_1/permissions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"permission_id":"perm_1"}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	assert.NoError(t, client.AddUserPermission(context.TODO(), "org_1", "kp_1", "perm_1"))
+	_, _ = client.GetUserPermissions(context.TODO(), "org_1", "kp_1")
+	_ = client.RemoveUserPermission(context.TODO(), "org_1", "kp_1", "perm_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/organizations/org_1/users/kp_1/permissions"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/organizations/org_1/users/kp_1/permissions"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/users/kp_1/permissions/perm_1"))
+}
+
+func TestConnections(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/connections", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","connections":[{"id":"conn_1","name":"acme-saml","strategy":"saml:custom"}]}`
+	})
+	res, err := client.GetConnections(context.TODO(), "org_1")
+	assert.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "conn_1", res[0].ID)
+
+	_ = client.EnableConnection(context.TODO(), "org_1", "conn_1")
+	_ = client.DisableConnection(context.TODO(), "org_1", "conn_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/organizations/org_1/connections/conn_1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/connections/conn_1"))
+}
+
+func TestUploadLogo(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/organizations/org_1/logos/dark", func(header http.Header, query url.Values, body []byte) (int, string) {
+		mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/form-data", mediaType)
+
+		form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+		require.NoError(t, err)
+		require.Len(t, form.File["logo"], 1)
+		assert.Equal(t, "logo.svg", form.File["logo"][0].Filename)
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organization", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"org_1","logo_dark":"https://cdn.example.com/logo.svg"}`
+	})
+
+	org, err := client.UploadLogo(context.TODO(), "org_1", organizations.LogoTypeDark, organizations.Image{
+		FileName:    "logo.svg",
+		ContentType: "image/svg+xml",
+		Content:     strings.NewReader("<svg></svg>"),
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, org)
+	require.NotNil(t, org.LogoDark)
+	assert.Equal(t, "https://cdn.example.com/logo.svg", *org.LogoDark)
+}
+
+func TestDeleteImages(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	_ = client.DeleteLogo(context.TODO(), "org_1", organizations.LogoTypeLight)
+	_ = client.DeleteFavicon(context.TODO(), "org_1", organizations.FaviconTypeSvg)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/logos/light"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organizations/org_1/favicons/svg"))
+
+	assert.Error(t, client.DeleteLogo(context.TODO(), "org_1", "medium"))
+}
+
+func TestDeleteHandle(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	_ = client.DeleteHandle(context.TODO(), "org_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/organization/org_1/handle"))
+}
+
+func TestUpdateSessionSettings(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, "", "/api/v1/organizations/org_1/sessions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if len(body) > 0 {
+			assert.Equal(t, `{"sso_session_persistence_mode":"non-persistent","is_use_org_authenticated_session_lifetime":true,"authenticated_session_lifetime":3600}`, string(body))
+			return http.StatusOK, `{"code":"OK"}`
+		}
+		return http.StatusOK, `{"code":"OK","sso_session_persistence_mode":"non-persistent","is_use_org_authenticated_session_lifetime":true,"authenticated_session_lifetime":3600}`
+	})
+
+	enabled, lifetime := true, 3600
+	settings, err := client.UpdateSessionSettings(context.TODO(), "org_1", organizations.SessionSettings{
+		SSOSessionPersistenceMode:            organizations.SessionPersistenceModeNonPersistent,
+		IsUseOrgAuthenticatedSessionLifetime: &enabled,
+		AuthenticatedSessionLifetime:         &lifetime,
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, settings)
+	require.NotNil(t, settings.AuthenticatedSessionLifetime)
+	assert.Equal(t, 3600, *settings.AuthenticatedSessionLifetime)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/organizations/org_1/sessions"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/organizations/org_1/sessions"))
+}
+
+func TestUpdateMFASettings(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/organizations/org_1/mfa", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"enabled_factors":["mfa:authenticator_app"]}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.UpdateMFASettings(context.TODO(), "org_1", organizations.MFASettings{
+		EnabledFactors: []organizations.MFAFactor{organizations.MFAFactorAuthenticatorApp},
+	})
+	assert.NoError(t, err)
+
+	err = client.UpdateMFASettings(context.TODO(), "org_1", organizations.MFASettings{
+		EnabledFactors: []organizations.MFAFactor{"mfa:pigeon"},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/organizations/org_1/mfa"))
+}
+
+func TestAddUserRoleRefreshClaims(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+
+	assert.NoError(t, client.AddUserRole(context.TODO(), "org_1", "kp_1", "role_1"))
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodPost, "/api/v1/users/kp_1/refresh_claims"))
+
+	assert.NoError(t, client.AddUserRole(context.TODO(), "org_1", "kp_1", "role_1", organizations.WithRefreshClaims()))
+	assert.NoError(t, client.RemoveUserPermission(context.TODO(), "org_1", "kp_1", "perm_1", organizations.WithRefreshClaims()))
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodPost, "/api/v1/organizations/org_1/users/kp_1/roles"))
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodPost, "/api/v1/users/kp_1/refresh_claims"))
+}
+
+func TestAddUserRoleRefreshClaimsFails(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := organizations.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/users/kp_1/refresh_claims", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusInternalServerError, `{"errors":[{"code":"INTERNAL_ERROR","message":"internal error"}]}`
+	})
+
+	err := client.AddUserRole(context.TODO(), "org_1", "kp_1", "role_1", organizations.WithRefreshClaims())
+	assert.ErrorIs(t, err, organizations.ErrRefreshFailed)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/organizations/org_1/users/kp_1/roles"))
+}
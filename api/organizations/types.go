@@ -1,6 +1,12 @@
 package organizations
 
-import "time"
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/internal/enum"
+)
 
 type Color struct {
 	Hex string `json:"hex"`
@@ -75,6 +81,7 @@ type UpdateParams struct {
 	AllowedDomains                 []string `json:"allowed_domains,omitempty"`
 	IsEnableAdvancedOrgs           bool     `json:"is_enable_advanced_orgs,omitempty"`
 	IsEnforceMfa                   bool     `json:"is_enforce_mfa,omitempty"`
+	IsAllowRegistrations           *bool    `json:"is_allow_registrations,omitempty"`
 }
 
 type UpdateResponse CreateResponse
@@ -106,3 +113,211 @@ type Role struct {
 	Key         string `json:"key"`
 	Description string `json:"description"`
 }
+
+// User is a member of an organization
+type User struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"full_name"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Picture   string    `json:"picture,omitempty"`
+	JoinedOn  time.Time `json:"joined_on,omitempty"`
+	// Roles holds the keys of the roles the user has in the organization
+	Roles []string `json:"roles"`
+}
+
+var _ enum.Enum[ListUsersSortMethod] = (*ListUsersSortMethod)(nil)
+
+type ListUsersSortMethod string
+
+const (
+	ListUsersSortNameAsc   ListUsersSortMethod = "name_asc"
+	ListUsersSortNameDesc  ListUsersSortMethod = "name_desc"
+	ListUsersSortEmailAsc  ListUsersSortMethod = "email_asc"
+	ListUsersSortEmailDesc ListUsersSortMethod = "email_desc"
+	ListUsersSortIDAsc     ListUsersSortMethod = "id_asc"
+	ListUsersSortIDDesc    ListUsersSortMethod = "id_desc"
+)
+
+func (t ListUsersSortMethod) Options() []ListUsersSortMethod {
+	return []ListUsersSortMethod{
+		ListUsersSortNameAsc,
+		ListUsersSortNameDesc,
+		ListUsersSortEmailAsc,
+		ListUsersSortEmailDesc,
+		ListUsersSortIDAsc,
+		ListUsersSortIDDesc,
+	}
+}
+
+func (t ListUsersSortMethod) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+type ListUsersParams struct {
+	Sort      ListUsersSortMethod
+	PageSize  int
+	NextToken string
+	// Roles only returns members with any of the given role keys
+	Roles []string
+	// Permissions only returns members with any of the given permission keys
+	Permissions []string
+	UserID      string
+}
+
+type ListUsersResponse struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Users     []User `json:"organization_users"`
+	NextToken string `json:"next_token"`
+}
+
+func (r ListUsersResponse) GetNextToken() string { return r.NextToken }
+
+func (r ListUsersResponse) GetData() []User { return r.Users }
+
+// ErrRefreshFailed is returned by the role and permission mutation helpers when
+// the mutation was applied but refreshing the user's claims failed afterwards,
+// retrying the mutation is not needed, only the refresh
+var ErrRefreshFailed = errors.New("mutation applied but refreshing claims failed")
+
+// MutationOption configures the role and permission mutation helpers
+type MutationOption func(*mutationOptions)
+
+type mutationOptions struct {
+	refreshClaims bool
+}
+
+// WithRefreshClaims refreshes the claims of the affected user once the
+// mutation succeeds, so their tokens reflect the change without signing in
+// again. A failed refresh is reported as ErrRefreshFailed, the mutation itself
+// has already been applied.
+func WithRefreshClaims() MutationOption {
+	return func(o *mutationOptions) {
+		o.refreshClaims = true
+	}
+}
+
+// PermissionRole is a role through which a member holds a permission
+type PermissionRole struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// Permission is a permission held by a member of an organization
+type Permission struct {
+	ID    string           `json:"id"`
+	Name  string           `json:"name"`
+	Key   string           `json:"key"`
+	Roles []PermissionRole `json:"roles,omitempty"`
+}
+
+var _ enum.Enum[LogoType] = (*LogoType)(nil)
+
+// LogoType selects the logo surfaced in Organization.Logo or
+// Organization.LogoDark
+type LogoType string
+
+const (
+	LogoTypeLight LogoType = "light"
+	LogoTypeDark  LogoType = "dark"
+)
+
+func (t LogoType) Options() []LogoType {
+	return []LogoType{
+		LogoTypeLight,
+		LogoTypeDark,
+	}
+}
+
+func (t LogoType) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+var _ enum.Enum[FaviconType] = (*FaviconType)(nil)
+
+// FaviconType selects the favicon surfaced in Organization.FaviconSvg or
+// Organization.FaviconFallback
+type FaviconType string
+
+const (
+	FaviconTypeSvg      FaviconType = "svg"
+	FaviconTypeFallback FaviconType = "fallback"
+)
+
+func (t FaviconType) Options() []FaviconType {
+	return []FaviconType{
+		FaviconTypeSvg,
+		FaviconTypeFallback,
+	}
+}
+
+func (t FaviconType) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// Image is an image file to upload
+type Image struct {
+	FileName    string
+	ContentType string
+	Content     io.Reader
+}
+
+var _ enum.Enum[SessionPersistenceMode] = (*SessionPersistenceMode)(nil)
+
+type SessionPersistenceMode string
+
+const (
+	SessionPersistenceModePersistent    SessionPersistenceMode = "persistent"
+	SessionPersistenceModeNonPersistent SessionPersistenceMode = "non-persistent"
+)
+
+func (t SessionPersistenceMode) Options() []SessionPersistenceMode {
+	return []SessionPersistenceMode{
+		SessionPersistenceModePersistent,
+		SessionPersistenceModeNonPersistent,
+	}
+}
+
+func (t SessionPersistenceMode) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// SessionSettings configures the sessions of an organization's members, the
+// authenticated session lifetime is in seconds. Nil fields are left unchanged
+// when updating.
+type SessionSettings struct {
+	IsUseOrgSSOSessionPolicy             *bool                  `json:"is_use_org_sso_session_policy,omitempty"`
+	SSOSessionPersistenceMode            SessionPersistenceMode `json:"sso_session_persistence_mode,omitempty"`
+	IsUseOrgAuthenticatedSessionLifetime *bool                  `json:"is_use_org_authenticated_session_lifetime,omitempty"`
+	AuthenticatedSessionLifetime         *int                   `json:"authenticated_session_lifetime,omitempty"`
+}
+
+var _ enum.Enum[MFAFactor] = (*MFAFactor)(nil)
+
+type MFAFactor string
+
+const (
+	MFAFactorEmail            MFAFactor = "mfa:email"
+	MFAFactorSMS              MFAFactor = "mfa:sms"
+	MFAFactorAuthenticatorApp MFAFactor = "mfa:authenticator_app"
+)
+
+func (t MFAFactor) Options() []MFAFactor {
+	return []MFAFactor{
+		MFAFactorEmail,
+		MFAFactorSMS,
+		MFAFactorAuthenticatorApp,
+	}
+}
+
+func (t MFAFactor) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// MFASettings lists the mfa factors members of an organization can use, mfa
+// itself is enforced with UpdateParams.IsEnforceMfa
+type MFASettings struct {
+	EnabledFactors []MFAFactor `json:"enabled_factors"`
+}
@@ -0,0 +1,279 @@
+package properties
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+type ListParams struct {
+	Context       Context
+	PageSize      int
+	StartingAfter string
+	EndingBefore  string
+}
+
+func (p ListParams) query() (url.Values, error) {
+	query := url.Values{}
+	if p.Context != "" {
+		if err := p.Context.Valid(); err != nil {
+			return nil, fmt.Errorf("invalid context: %w", err)
+		}
+		query.Set("context", string(p.Context))
+	}
+
+	if p.PageSize > 0 {
+		query.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+
+	if p.StartingAfter != "" {
+		query.Set("starting_after", p.StartingAfter)
+	}
+
+	if p.EndingBefore != "" {
+		query.Set("ending_before", p.EndingBefore)
+	}
+
+	return query, nil
+}
+
+type ListResponse struct {
+	Code       string     `json:"code"`
+	Message    string     `json:"message"`
+	HasMore    bool       `json:"has_more"`
+	Properties []Property `json:"properties"`
+}
+
+func (r ListResponse) GetNextToken() string {
+	if !r.HasMore || len(r.Properties) == 0 {
+		return ""
+	}
+
+	return r.Properties[len(r.Properties)-1].ID
+}
+
+func (r ListResponse) GetData() []Property { return r.Properties }
+
+// https://kinde.com/api/docs/#get-properties
+//
+// note: returns a single page, use ListPaginator to iterate over every page
+func (c *Client) List(ctx context.Context, params ListParams) ([]Property, error) {
+	query, err := params.query()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/api/v1/properties"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Properties, nil
+}
+
+// ListPaginator returns a paginator iterating over every page of properties
+func (c *Client) ListPaginator(params ListParams) (*client.Paginator[Property, ListResponse], error) {
+	query, err := params.query()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := client.PaginatorOptions{
+		PageSize:   params.PageSize,
+		Query:      query,
+		TokenParam: "starting_after",
+	}
+
+	return client.NewPaginator[Property, ListResponse](c, "/api/v1/properties", opts), nil
+}
+
+type CreateParams struct {
+	Name        string  `json:"name"`
+	Key         string  `json:"key"`
+	Description string  `json:"description,omitempty"`
+	Type        Type    `json:"type"`
+	Context     Context `json:"context"`
+	IsPrivate   bool    `json:"is_private"`
+	CategoryID  string  `json:"category_id"`
+}
+
+type CreateResponse struct {
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+	Property Property `json:"property"`
+}
+
+// https://kinde.com/api/docs/#create-property
+//
+// note: only ID will be populated
+func (c *Client) Create(ctx context.Context, params CreateParams) (*Property, error) {
+	if err := params.Type.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid type: %w", err)
+	}
+
+	if err := params.Context.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid context: %w", err)
+	}
+
+	endpoint := "/api/v1/properties"
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Property, nil
+}
+
+type UpdateParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   bool   `json:"is_private"`
+	CategoryID  string `json:"category_id"`
+}
+
+// https://kinde.com/api/docs/#update-property
+func (c *Client) Update(ctx context.Context, id string, params UpdateParams) error {
+	endpoint := fmt.Sprintf("/api/v1/properties/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#delete-property
+func (c *Client) Delete(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/api/v1/properties/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type ListCategoriesResponse struct {
+	Code       string     `json:"code"`
+	Message    string     `json:"message"`
+	HasMore    bool       `json:"has_more"`
+	Categories []Category `json:"property_categories"`
+}
+
+// https://kinde.com/api/docs/#get-categories
+func (c *Client) ListCategories(ctx context.Context, params ListParams) ([]Category, error) {
+	query, err := params.query()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/api/v1/property_categories"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListCategoriesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Categories, nil
+}
+
+type CreateCategoryParams struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+type CreateCategoryResponse struct {
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+	Category Category `json:"category"`
+}
+
+// https://kinde.com/api/docs/#create-category
+//
+// note: only ID will be populated
+func (c *Client) CreateCategory(ctx context.Context, params CreateCategoryParams) (*Category, error) {
+	if err := params.Context.Valid(); err != nil {
+		return nil, fmt.Errorf("invalid context: %w", err)
+	}
+
+	endpoint := "/api/v1/property_categories"
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateCategoryResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Category, nil
+}
+
+type UpdateCategoryParams struct {
+	Name string `json:"name"`
+}
+
+// https://kinde.com/api/docs/#update-category
+func (c *Client) UpdateCategory(ctx context.Context, id string, params UpdateCategoryParams) error {
+	endpoint := fmt.Sprintf("/api/v1/property_categories/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCategory deletes a property category
+func (c *Client) DeleteCategory(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/api/v1/property_categories/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+//go:build e2e
+// +build e2e
+
+package properties_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/properties"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE2EList(t *testing.T) {
+	client := properties.New(testutil.DefaultE2EClient(t))
+	res, err := client.List(context.TODO(), properties.ListParams{Context: properties.ContextUser})
+	assert.NoError(t, err)
+	t.Logf("found %d properties", len(res))
+}
+
+func TestE2ECreateUpdateDelete(t *testing.T) {
+	client := properties.New(testutil.DefaultE2EClient(t))
+	tempID := fmt.Sprintf("test_%d", time.Now().UnixMilli())
+
+	category, err := client.CreateCategory(context.TODO(), properties.CreateCategoryParams{
+		Name:    tempID,
+		Context: properties.ContextOrganization,
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, category)
+
+	t.Logf("created test category: %s\n", category.ID)
+
+	property, err := client.Create(context.TODO(), properties.CreateParams{
+		Name:       tempID,
+		Key:        tempID,
+		Type:       properties.TypeSingleLineText,
+		Context:    properties.ContextOrganization,
+		CategoryID: category.ID,
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, property)
+
+	t.Logf("created test property: %s\n", property.ID)
+
+	err = client.Update(context.TODO(), property.ID, properties.UpdateParams{
+		Name:       tempID + "2",
+		IsPrivate:  true,
+		CategoryID: category.ID,
+	})
+	assert.NoError(t, err)
+
+	t.Logf("updated test property: %s\n", property.ID)
+
+	err = client.Delete(context.TODO(), property.ID)
+	assert.NoError(t, err)
+
+	t.Logf("deleted test property: %s\n", property.ID)
+}
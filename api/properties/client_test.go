@@ -0,0 +1,109 @@
+package properties_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/properties"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/enum"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "org", query.Get("context"))
+		return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_1","key":"plan_tier"}],"has_more":false}`
+	})
+	res, err := client.List(context.TODO(), properties.ListParams{Context: properties.ContextOrganization})
+	assert.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "plan_tier", res[0].Key)
+}
+
+func TestListInvalidContext(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	_, err := client.List(context.TODO(), properties.ListParams{Context: "user"})
+	assert.ErrorAs(t, err, &enum.InvalidEnumMemberError{})
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodGet, "/api/v1/properties"))
+}
+
+func TestListPaginator(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("starting_after") == "" {
+			return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_1"}],"has_more":true}`
+		}
+
+		assert.Equal(t, "prop_1", query.Get("starting_after"))
+		return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_2"}],"has_more":false}`
+	})
+
+	paginator, err := client.ListPaginator(properties.ListParams{PageSize: 1})
+	require.NoError(t, err)
+
+	var res []properties.Property
+	for paginator.HasNext() {
+		page, err := paginator.Next(context.TODO())
+		require.NoError(t, err)
+		res = append(res, page...)
+	}
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/properties"))
+}
+
+func TestCreate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"name":"Plan tier","key":"plan_tier","type":"single_line_text","context":"org","is_private":true,"category_id":"cat_1"}`, string(body))
+		return http.StatusOK, `{"code":"OK","property":{"id":"prop_1"}}`
+	})
+	res, err := client.Create(context.TODO(), properties.CreateParams{
+		Name:       "Plan tier",
+		Key:        "plan_tier",
+		Type:       properties.TypeSingleLineText,
+		Context:    properties.ContextOrganization,
+		IsPrivate:  true,
+		CategoryID: "cat_1",
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "prop_1", res.ID)
+}
+
+func TestUpdate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	_ = client.Update(context.TODO(), "1", properties.UpdateParams{})
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/properties/1"))
+}
+
+func TestDelete(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	_ = client.Delete(context.TODO(), "1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/properties/1"))
+}
+
+func TestCategories(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := properties.New(client.New(context.TODO(), nil))
+	_, _ = client.ListCategories(context.TODO(), properties.ListParams{})
+	_, _ = client.CreateCategory(context.TODO(), properties.CreateCategoryParams{Name: "Billing", Context: properties.ContextUser})
+	_ = client.UpdateCategory(context.TODO(), "1", properties.UpdateCategoryParams{Name: "Billing"})
+	_ = client.DeleteCategory(context.TODO(), "1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/property_categories"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/property_categories"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/property_categories/1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/property_categories/1"))
+}
@@ -0,0 +1,62 @@
+package properties
+
+import "github.com/nxt-fwd/kinde-go/internal/enum"
+
+// https://kinde.com/api/docs/#kinde-management-api-properties
+type Property struct {
+	ID              string `json:"id"`
+	Key             string `json:"key"`
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	IsPrivate       bool   `json:"is_private"`
+	IsKindeProperty bool   `json:"is_kinde_property"`
+}
+
+// https://kinde.com/api/docs/#kinde-management-api-property-categories
+type Category struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+var _ enum.Enum[Context] = (*Context)(nil)
+
+// Context is the kind of entity a property or category applies to
+type Context string
+
+const (
+	ContextUser         Context = "usr"
+	ContextOrganization Context = "org"
+	ContextApplication  Context = "app"
+)
+
+func (t Context) Options() []Context {
+	return []Context{
+		ContextUser,
+		ContextOrganization,
+		ContextApplication,
+	}
+}
+
+func (t Context) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+var _ enum.Enum[Type] = (*Type)(nil)
+
+type Type string
+
+const (
+	TypeSingleLineText Type = "single_line_text"
+	TypeMultiLineText  Type = "multi_line_text"
+)
+
+func (t Type) Options() []Type {
+	return []Type{
+		TypeSingleLineText,
+		TypeMultiLineText,
+	}
+}
+
+func (t Type) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
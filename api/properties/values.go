@@ -0,0 +1,239 @@
+package properties
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Value is the value of a property set on a user or organization
+type Value struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value"`
+}
+
+type Values []Value
+
+// Map returns the values keyed by property key
+func (v Values) Map() map[string]string {
+	m := make(map[string]string, len(v))
+	for _, value := range v {
+		m[value.Key] = value.Value
+	}
+
+	return m
+}
+
+type ValuesResponse struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	NextToken  string `json:"next_token"`
+	Properties Values `json:"properties"`
+}
+
+func (r ValuesResponse) GetNextToken() string { return r.NextToken }
+
+func (r ValuesResponse) GetData() []Value { return r.Properties }
+
+type UpdateValuesParams struct {
+	Properties map[string]string `json:"properties"`
+}
+
+// tagName is the struct tag used by Marshal and Unmarshal, the tag value is the
+// property key optionally followed by ",omitempty"
+//
+//	type Billing struct {
+//		PlanTier string `kinde:"plan_tier"`
+//		CrmID    string `kinde:"crm_id,omitempty"`
+//	}
+const tagName = "kinde"
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+type taggedField struct {
+	key       string
+	omitempty bool
+	index     int
+}
+
+func taggedFields(t reflect.Type) []taggedField {
+	fields := make([]taggedField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		key, opts, _ := strings.Cut(tag, ",")
+		fields = append(fields, taggedField{
+			key:       key,
+			omitempty: opts == "omitempty",
+			index:     i,
+		})
+	}
+
+	return fields
+}
+
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("properties: nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("properties: expected struct, got %s", rv.Type())
+	}
+
+	return rv, nil
+}
+
+// Marshal converts a struct with kinde tags into property values keyed by
+// property key. Nil pointers and empty omitempty fields are skipped.
+func Marshal(v any) (map[string]string, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, field := range taggedFields(rv.Type()) {
+		fv := rv.Field(field.index)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		if field.omitempty && fv.IsZero() {
+			continue
+		}
+
+		value, err := formatValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("properties: field %s: %w", field.key, err)
+		}
+
+		values[field.key] = value
+	}
+
+	return values, nil
+}
+
+// Unmarshal populates a struct with kinde tags from property values keyed by
+// property key. Missing keys leave the field untouched.
+func Unmarshal(values map[string]string, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("properties: Unmarshal requires a non-nil pointer")
+	}
+
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range taggedFields(rv.Type()) {
+		value, ok := values[field.key]
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(field.index)
+		typ := fv.Type()
+		if fv.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+
+		// parse into a temporary so a failure leaves the field untouched
+		parsed := reflect.New(typ)
+		if err := parseValue(parsed.Elem(), value); err != nil {
+			return fmt.Errorf("properties: field %s: %w", field.key, err)
+		}
+
+		switch {
+		case fv.Kind() != reflect.Pointer:
+			fv.Set(parsed.Elem())
+		case fv.IsNil():
+			fv.Set(parsed)
+		default:
+			fv.Elem().Set(parsed.Elem())
+		}
+	}
+
+	return nil
+}
+
+func formatValue(v reflect.Value) (string, error) {
+	if v.Type().Implements(textMarshalerType) {
+		raw, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(raw), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func parseValue(v reflect.Value, raw string) error {
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package properties_test
+
+import (
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/properties"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type billing struct {
+	PlanTier string `kinde:"plan_tier"`
+	CrmID    string `kinde:"crm_id,omitempty"`
+	Seats    int    `kinde:"seats"`
+	Trial    *bool  `kinde:"is_trial"`
+	Ignored  string
+}
+
+func TestMarshal(t *testing.T) {
+	values, err := properties.Marshal(billing{PlanTier: "gold", Seats: 5, Ignored: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"plan_tier": "gold", "seats": "5"}, values)
+
+	trial := true
+	values, err = properties.Marshal(&billing{CrmID: "crm_1", Trial: &trial})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"plan_tier": "", "crm_id": "crm_1", "seats": "0", "is_trial": "true"}, values)
+
+	_, err = properties.Marshal("not a struct")
+	assert.Error(t, err)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var target billing
+	err := properties.Unmarshal(map[string]string{"plan_tier": "gold", "seats": "5", "is_trial": "false", "unknown": "x"}, &target)
+	require.NoError(t, err)
+	assert.Equal(t, "gold", target.PlanTier)
+	assert.Equal(t, 5, target.Seats)
+	require.NotNil(t, target.Trial)
+	assert.False(t, *target.Trial)
+
+	err = properties.Unmarshal(map[string]string{"seats": "five"}, &target)
+	assert.Error(t, err)
+	assert.Equal(t, 5, target.Seats)
+
+	// a failed parse leaves pointer fields untouched
+	var fresh billing
+	err = properties.Unmarshal(map[string]string{"is_trial": "maybe"}, &fresh)
+	assert.Error(t, err)
+	assert.Nil(t, fresh.Trial)
+
+	err = properties.Unmarshal(map[string]string{"is_trial": "maybe"}, &target)
+	assert.Error(t, err)
+	require.NotNil(t, target.Trial)
+	assert.False(t, *target.Trial)
+
+	err = properties.Unmarshal(map[string]string{}, target)
+	assert.Error(t, err)
+}
+
+func TestValuesMap(t *testing.T) {
+	values := properties.Values{{Key: "plan_tier", Value: "gold"}, {Key: "crm_id", Value: "crm_1"}}
+	assert.Equal(t, map[string]string{"plan_tier": "gold", "crm_id": "crm_1"}, values.Map())
+}
@@ -75,6 +75,22 @@ func (c *Client) Get(ctx context.Context, id string) (*Role, error) {
 	return &response.Role, nil
 }
 
+// GetWithScopes gets role details including its permissions and api scopes
+func (c *Client) GetWithScopes(ctx context.Context, id string) (*Role, error) {
+	role, err := c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := c.GetRoleScopes(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role scopes: %w", err)
+	}
+	role.Scopes = scopes
+
+	return role, nil
+}
+
 // GetRolePermissions gets all permissions assigned to a role
 func (c *Client) GetRolePermissions(ctx context.Context, roleID string) ([]string, error) {
 	endpoint := fmt.Sprintf("/api/v1/roles/%s/permissions", roleID)
@@ -185,3 +201,55 @@ func (c *Client) ListPermissions(ctx context.Context) ([]Permission, error) {
 
 	return response.Permissions, nil
 }
+
+// GetRoleScopes gets all api scopes assigned to a role
+func (c *Client) GetRoleScopes(ctx context.Context, roleID string) ([]Scope, error) {
+	endpoint := fmt.Sprintf("/api/v1/roles/%s/scopes", roleID)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetRoleScopesResponse
+	if reqErr := c.DoRequest(req, &response); reqErr != nil {
+		return nil, reqErr
+	}
+
+	if response.Scopes == nil {
+		response.Scopes = make([]Scope, 0)
+	}
+
+	return response.Scopes, nil
+}
+
+// AddScope adds an api scope to a role
+func (c *Client) AddScope(ctx context.Context, roleID string, scopeID string) error {
+	endpoint := fmt.Sprintf("/api/v1/roles/%s/scopes", roleID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, AddScopeParams{ScopeID: scopeID})
+	if err != nil {
+		return err
+	}
+
+	var response AddScopeResponse
+	if reqErr := c.DoRequest(req, &response); reqErr != nil {
+		return reqErr
+	}
+
+	return nil
+}
+
+// RemoveScope removes a specific api scope from a role
+func (c *Client) RemoveScope(ctx context.Context, roleID string, scopeID string) error {
+	endpoint := fmt.Sprintf("/api/v1/roles/%s/scopes/%s", roleID, scopeID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response RemoveScopeResponse
+	if reqErr := c.DoRequest(req, &response); reqErr != nil {
+		return reqErr
+	}
+
+	return nil
+}
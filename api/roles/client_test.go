@@ -0,0 +1,55 @@
+package roles_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/roles"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWithScopes(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := roles.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/roles/1", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","role":{"id":"1","key":"billing"}}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/roles/1/permissions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","permissions":[{"id":"perm_1"}]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/roles/1/scopes", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","scopes":[{"id":"scope_1","key":"read:invoices","api_id":"api_1"}]}`
+	})
+
+	role, err := client.GetWithScopes(context.TODO(), "1")
+	assert.NoError(t, err)
+	require.NotNil(t, role)
+	assert.Equal(t, []string{"perm_1"}, role.Permissions)
+	require.Len(t, role.Scopes, 1)
+	assert.Equal(t, "read:invoices", role.Scopes[0].Key)
+	assert.Equal(t, "api_1", role.Scopes[0].APIID)
+}
+
+func TestAddScope(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := roles.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPost, "/api/v1/roles/1/scopes", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"scope_id":"scope_1"}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.AddScope(context.TODO(), "1", "scope_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/roles/1/scopes"))
+}
+
+func TestRemoveScope(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := roles.New(client.New(context.TODO(), nil))
+	_ = client.RemoveScope(context.TODO(), "1", "scope_1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/roles/1/scopes/scope_1"))
+}
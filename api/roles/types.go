@@ -6,6 +6,7 @@ type Role struct {
 	Key           string   `json:"key"`
 	Description   string   `json:"description,omitempty"`
 	Permissions   []string `json:"permissions,omitempty"`
+	Scopes        []Scope  `json:"scopes,omitempty"`
 	IsDefaultRole bool     `json:"is_default_role"`
 }
 
@@ -76,3 +77,31 @@ type ListPermissionsResponse struct {
 	NextToken   string       `json:"next_token"`
 	Permissions []Permission `json:"permissions,omitempty"`
 }
+
+// Scope is an api scope carried by a role
+type Scope struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Description string `json:"description,omitempty"`
+	APIID       string `json:"api_id"`
+}
+
+type GetRoleScopesResponse struct {
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Scopes  []Scope `json:"scopes"`
+}
+
+type AddScopeParams struct {
+	ScopeID string `json:"scope_id"`
+}
+
+type AddScopeResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type RemoveScopeResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
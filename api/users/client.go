@@ -5,7 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/api/properties"
 	"github.com/nxt-fwd/kinde-go/internal/client"
 	"github.com/nxt-fwd/kinde-go/internal/phone"
 )
@@ -45,8 +50,140 @@ func (c *Client) List(ctx context.Context, params ListParams) ([]User, error) {
 	return response.Users, nil
 }
 
-// Create a new user
+func searchQuery(params SearchParams) (url.Values, error) {
+	query := url.Values{}
+	if params.Query != "" {
+		query.Set("query", params.Query)
+	}
+	for key, value := range params.Properties {
+		query.Set(fmt.Sprintf("properties[%s]", key), value)
+	}
+	if len(params.Expand) > 0 {
+		expand := make([]string, 0, len(params.Expand))
+		for _, e := range params.Expand {
+			if err := e.Valid(); err != nil {
+				return nil, fmt.Errorf("invalid expand option: %w", err)
+			}
+			expand = append(expand, string(e))
+		}
+		query.Set("expand", strings.Join(expand, ","))
+	}
+	if params.PageSize > 0 {
+		query.Set("page_size", fmt.Sprintf("%d", params.PageSize))
+	}
+	if params.StartingAfter != "" {
+		query.Set("starting_after", params.StartingAfter)
+	}
+	if params.EndingBefore != "" {
+		query.Set("ending_before", params.EndingBefore)
+	}
+
+	return query, nil
+}
+
+// Search users by partial name, email or username and custom property values,
+// returning a single page of results
+//
+// https://kinde.com/api/docs/#search-users
+func (c *Client) Search(ctx context.Context, params SearchParams) ([]SearchResult, error) {
+	query, err := searchQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/api/v1/search/users"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SearchResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// DefaultSearchPageSize is the page size used by SearchPaginator when
+// SearchParams.PageSize is not set
+const DefaultSearchPageSize = 50
+
+// SearchResultsPaginator iterates over pages of search results. The search
+// endpoint does not report whether more results exist, iteration stops at the
+// first page shorter than the page size.
+type SearchResultsPaginator struct {
+	pages    *client.Paginator[SearchResult, SearchResponse]
+	pageSize int
+	done     bool
+}
+
+func (p *SearchResultsPaginator) HasNext() bool {
+	return !p.done && p.pages.HasNext()
+}
+
+func (p *SearchResultsPaginator) Next(ctx context.Context) ([]SearchResult, error) {
+	results, err := p.pages.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) < p.pageSize {
+		p.done = true
+	}
+
+	return results, nil
+}
+
+// SearchPaginator returns a paginator iterating forward over every page of
+// search results, starting after params.StartingAfter when set.
+// params.EndingBefore is not supported.
+func (c *Client) SearchPaginator(params SearchParams) (*SearchResultsPaginator, error) {
+	if params.EndingBefore != "" {
+		return nil, fmt.Errorf("ending before is not supported when paginating search results")
+	}
+
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultSearchPageSize
+	}
+
+	// the start cursor is sent as the first page token
+	startingAfter := params.StartingAfter
+	params.StartingAfter = ""
+
+	query, err := searchQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := client.PaginatorOptions{
+		PageSize:   params.PageSize,
+		Query:      query,
+		TokenParam: "starting_after",
+		NextToken:  startingAfter,
+	}
+
+	return &SearchResultsPaginator{
+		pages:    client.NewPaginator[SearchResult, SearchResponse](c, "/api/v1/search/users", opts),
+		pageSize: params.PageSize,
+	}, nil
+}
+
+// Create a new user, identities are validated and phone numbers normalized
+// before the request is sent
 func (c *Client) Create(ctx context.Context, params CreateParams) (*User, error) {
+	if len(params.Identities) > 0 {
+		identities := make([]CreateIdentity, 0, len(params.Identities))
+		for _, identity := range params.Identities {
+			normalized, err := identity.normalize()
+			if err != nil {
+				return nil, err
+			}
+			identities = append(identities, normalized)
+		}
+		params.Identities = identities
+	}
+
 	endpoint := "/api/v1/user"
 	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
 	if err != nil {
@@ -133,6 +270,30 @@ func (c *Client) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// SetPassword sets or resets the password of a user from an existing hash, see
+// HashedPassword
+func (c *Client) SetPassword(ctx context.Context, userID string, params SetPasswordParams) error {
+	if err := params.validate(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/password", userID)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // addIdentityRequest handles the common API request logic for adding identities
 func (c *Client) addIdentityRequest(ctx context.Context, userID string, params AddIdentityParams) (*Identity, error) {
 	endpoint := fmt.Sprintf("/api/v1/users/%s/identities", userID)
@@ -177,22 +338,533 @@ func (c *Client) AddIdentity(ctx context.Context, userID string, params AddIdent
 	return c.addIdentityRequest(ctx, userID, params)
 }
 
-// GetIdentities gets all identities for a user
-func (c *Client) GetIdentities(ctx context.Context, userID string) ([]Identity, error) {
+// ListIdentities gets a page of identities for a user
+//
+// note: returns a single page, use ListIdentitiesPaginator to iterate over
+// every page
+func (c *Client) ListIdentities(ctx context.Context, userID string, params ListIdentitiesParams) ([]Identity, error) {
 	endpoint := fmt.Sprintf("/api/v1/users/%s/identities", userID)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, params.query(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListIdentitiesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Identities == nil {
+		response.Identities = make([]Identity, 0)
+	}
+
+	return response.Identities, nil
+}
+
+// ListIdentitiesPaginator returns a paginator iterating forward over every
+// page of identities for a user, starting after params.StartingAfter when set.
+// params.EndingBefore is not supported.
+func (c *Client) ListIdentitiesPaginator(userID string, params ListIdentitiesParams) (*client.Paginator[Identity, ListIdentitiesResponse], error) {
+	if params.EndingBefore != "" {
+		return nil, fmt.Errorf("ending before is not supported when paginating identities")
+	}
+
+	// the start cursor is sent as the first page token
+	startingAfter := params.StartingAfter
+	params.StartingAfter = ""
+
+	opts := client.PaginatorOptions{
+		PageSize:   params.PageSize,
+		Query:      params.query(),
+		TokenParam: "starting_after",
+		NextToken:  startingAfter,
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/identities", userID)
+	return client.NewPaginator[Identity, ListIdentitiesResponse](c, endpoint, opts), nil
+}
+
+// GetIdentities gets all identities for a user, following every page
+func (c *Client) GetIdentities(ctx context.Context, userID string) ([]Identity, error) {
+	paginator, err := c.ListIdentitiesPaginator(userID, ListIdentitiesParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make([]Identity, 0)
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		identities = append(identities, page...)
+	}
+
+	return identities, nil
+}
+
+// GetMFAFactors gets the mfa factors a user has enrolled
+func (c *Client) GetMFAFactors(ctx context.Context, userID string) ([]MFAFactor, error) {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/mfa", userID)
 	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var response struct {
-		Code       string     `json:"code"`
-		Message    string     `json:"message"`
-		Identities []Identity `json:"identities"`
+		Code    string     `json:"code"`
+		Message string     `json:"message"`
+		MFA     *MFAFactor `json:"mfa"`
 	}
 	if err := c.DoRequest(req, &response); err != nil {
 		return nil, err
 	}
 
-	return response.Identities, nil
+	// The api returns the enrolled factor, if any, as a single object
+	factors := make([]MFAFactor, 0)
+	if response.MFA != nil {
+		factors = append(factors, *response.MFA)
+	}
+
+	return factors, nil
+}
+
+// ResetMFAFactor removes a single mfa factor from a user, they will be asked
+// to enroll again on next sign in
+func (c *Client) ResetMFAFactor(ctx context.Context, userID string, factorID string) error {
+	// an empty id would reset every factor of the user
+	if factorID == "" {
+		return fmt.Errorf("mfa factor id is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/mfa/%s", userID, factorID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResetMFAFactors removes every mfa factor from a user
+func (c *Client) ResetMFAFactors(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/mfa", userID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResetMFAFactorsByType removes every enrolled mfa factor of type t from a
+// user, other factors are kept
+func (c *Client) ResetMFAFactorsByType(ctx context.Context, userID string, t MFAFactorType) error {
+	if err := t.Valid(); err != nil {
+		return err
+	}
+
+	factors, err := c.GetMFAFactors(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, factor := range factors {
+		if factor.Type != t {
+			continue
+		}
+
+		if err := c.ResetMFAFactor(ctx, userID, factor.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListSessionsPaginator returns a paginator iterating over every page of
+// active sessions of a user
+func (c *Client) ListSessionsPaginator(userID string) *client.Paginator[Session, ListSessionsResponse] {
+	opts := client.PaginatorOptions{
+		TokenParam: "starting_after",
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/sessions", userID)
+	return client.NewPaginator[Session, ListSessionsResponse](c, endpoint, opts)
+}
+
+// ListSessions gets the active sessions of a user, following every page
+func (c *Client) ListSessions(ctx context.Context, userID string) ([]Session, error) {
+	paginator := c.ListSessionsPaginator(userID)
+
+	sessions := make([]Session, 0)
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, page...)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession ends a single session of a user
+func (c *Client) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/sessions/%s", userID, sessionID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeSessions ends every session of a user
+func (c *Client) RevokeSessions(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/sessions", userID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Lock suspends a user and revokes all their sessions so they are signed out
+// immediately and cannot sign in again until unsuspended
+//
+// note: the user is suspended first, a failure to revoke sessions leaves the
+// user suspended
+func (c *Client) Lock(ctx context.Context, userID string) (*User, error) {
+	suspended := true
+	user, err := c.Update(ctx, userID, UpdateParams{IsSuspended: &suspended})
+	if err != nil {
+		return nil, fmt.Errorf("failed to suspend user: %w", err)
+	}
+
+	if err := c.RevokeSessions(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return user, nil
+}
+
+// RefreshClaims asks kinde to refresh the claims of a user's tokens, picking
+// up role and permission changes without the user signing in again
+func (c *Client) RefreshClaims(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/refresh_claims", userID)
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOrganizations gets the codes of the organizations a user belongs to
+func (c *Client) GetOrganizations(ctx context.Context, userID string) ([]string, error) {
+	query := url.Values{}
+	query.Set("id", userID)
+	query.Set("expand", "organizations")
+
+	endpoint := "/api/v1/user"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Organizations []string `json:"organizations"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Organizations == nil {
+		response.Organizations = make([]string, 0)
+	}
+
+	return response.Organizations, nil
+}
+
+const DefaultMembershipConcurrency = 4
+
+// GetMemberships gets the organizations a user belongs to together with the
+// roles and permissions they hold in each, fetching at most concurrency
+// memberships at a time. The order of organizations is preserved and the first
+// error aborts the remaining requests.
+//
+// note: roles and permissions are fetched per organization, this makes two
+// requests for every organization of the user
+func (c *Client) GetMemberships(ctx context.Context, userID string, concurrency int) ([]Membership, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultMembershipConcurrency
+	}
+
+	orgCodes, err := c.GetOrganizations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	memberships := make([]Membership, len(orgCodes))
+	sem := make(chan struct{}, concurrency)
+	errs := make(chan error, 1)
+	var wg sync.WaitGroup
+
+	for i, orgCode := range orgCodes {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, orgCode string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			membership, err := c.GetMembership(ctx, userID, orgCode)
+			if err != nil {
+				select {
+				case errs <- err:
+					cancel()
+				default:
+				}
+				return
+			}
+
+			memberships[i] = *membership
+		}(i, orgCode)
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+// GetMembership gets the roles and permissions a user holds in an organization
+func (c *Client) GetMembership(ctx context.Context, userID string, orgCode string) (*Membership, error) {
+	orgs := organizations.New(c.Client)
+
+	roles, err := orgs.GetUserRoles(ctx, orgCode, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles in %s: %w", orgCode, err)
+	}
+
+	permissions, err := orgs.GetUserPermissions(ctx, orgCode, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions in %s: %w", orgCode, err)
+	}
+
+	return &Membership{
+		OrgCode:     orgCode,
+		Roles:       roles,
+		Permissions: permissions,
+	}, nil
+}
+
+// ListFeatureFlags gets the effective feature flag values for a user
+func (c *Client) ListFeatureFlags(ctx context.Context, userID string) (featureflags.Values, error) {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/feature_flags", userID)
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response featureflags.ValuesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	if response.FeatureFlags == nil {
+		response.FeatureFlags = featureflags.Values{}
+	}
+
+	return response.FeatureFlags, nil
+}
+
+// SetFeatureFlag overrides a feature flag value for a user
+func (c *Client) SetFeatureFlag(ctx context.Context, userID string, key string, value featureflags.Value) error {
+	if value.IsZero() {
+		return fmt.Errorf("feature flag value is required")
+	}
+
+	query := url.Values{}
+	query.Set("value", value.String())
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/feature_flags/%s", userID, key)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, query, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverride resets a feature flag to its default value for a
+// user
+func (c *Client) DeleteFeatureFlagOverride(ctx context.Context, userID string, key string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/feature_flags/%s", userID, key)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverrides resets every feature flag to its default value for
+// a user
+func (c *Client) DeleteFeatureFlagOverrides(ctx context.Context, userID string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/feature_flags", userID)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetProperties gets all property values set on a user, following every page
+func (c *Client) GetProperties(ctx context.Context, userID string) (properties.Values, error) {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/properties", userID)
+	paginator := client.NewPaginator[properties.Value, properties.ValuesResponse](c, endpoint, client.PaginatorOptions{})
+
+	values := make(properties.Values, 0)
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, page...)
+	}
+
+	return values, nil
+}
+
+// SetProperty sets a single property value on a user
+func (c *Client) SetProperty(ctx context.Context, userID string, key string, value string) error {
+	query := url.Values{}
+	query.Set("value", value)
+
+	endpoint := fmt.Sprintf("/api/v1/users/%s/properties/%s", userID, key)
+	req, err := c.NewRequest(ctx, http.MethodPut, endpoint, query, nil)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProperties sets many property values on a user in one call, values
+// can be built from a tagged struct with properties.Marshal
+func (c *Client) UpdateProperties(ctx context.Context, userID string, values map[string]string) error {
+	endpoint := fmt.Sprintf("/api/v1/users/%s/properties", userID)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, properties.UpdateValuesParams{
+		Properties: values,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.DoRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
 }
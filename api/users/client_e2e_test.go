@@ -290,7 +290,7 @@ func TestE2EPhoneIdentity(t *testing.T) {
 
 			var found bool
 			for _, identity := range identities {
-				if identity.Type == string(users.IdentityTypePhone) && identity.Name == tt.phoneNumber {
+				if identity.Type == users.IdentityTypePhone && identity.Name == tt.phoneNumber {
 					found = true
 					break
 				}
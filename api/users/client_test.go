@@ -0,0 +1,474 @@
+package users_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
+	"github.com/nxt-fwd/kinde-go/api/users"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSearch(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/search/users", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "jane", query.Get("query"))
+		assert.Equal(t, "gold", query.Get("properties[plan_tier]"))
+		assert.Equal(t, "organizations,identities", query.Get("expand"))
+		assert.Equal(t, "10", query.Get("page_size"))
+		return http.StatusOK, `{"code":"OK","results":[{"id":"kp_1","email":"jane@example.com","organizations":["org_1"],"identities":[{"type":"email","identity":"jane@example.com"}]}]}`
+	})
+
+	results, err := client.Search(context.TODO(), users.SearchParams{
+		Query:      "jane",
+		Properties: map[string]string{"plan_tier": "gold"},
+		Expand:     []users.SearchExpand{users.SearchExpandOrganizations, users.SearchExpandIdentities},
+		PageSize:   10,
+	})
+	assert.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "kp_1", results[0].ID)
+	assert.Equal(t, []string{"org_1"}, results[0].Organizations)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/search/users"))
+}
+
+func TestSearchInvalidExpand(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	_, err := client.Search(context.TODO(), users.SearchParams{Expand: []users.SearchExpand{"billing"}})
+	assert.Error(t, err)
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodGet, "/api/v1/search/users"))
+}
+
+func TestSearchPaginator(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/search/users", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "jane", query.Get("query"))
+		if query.Get("starting_after") == "" {
+			return http.StatusOK, `{"code":"OK","results":[{"id":"kp_1"},{"id":"kp_2"}]}`
+		}
+
+		assert.Equal(t, "kp_2", query.Get("starting_after"))
+		assert.Empty(t, query.Get("ending_before"))
+		return http.StatusOK, `{"code":"OK","results":[{"id":"kp_3"}]}`
+	})
+
+	paginator, err := client.SearchPaginator(users.SearchParams{Query: "jane", PageSize: 2})
+	require.NoError(t, err)
+
+	var results []users.SearchResult
+	for paginator.HasNext() {
+		page, err := paginator.Next(context.TODO())
+		require.NoError(t, err)
+		results = append(results, page...)
+	}
+
+	// the short second page ends iteration without requesting an empty page
+	assert.Len(t, results, 3)
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/search/users"))
+
+	paginator, err = client.SearchPaginator(users.SearchParams{Query: "jane", PageSize: 2, StartingAfter: "kp_2"})
+	require.NoError(t, err)
+
+	page, err := paginator.Next(context.TODO())
+	require.NoError(t, err)
+	assert.Len(t, page, 1)
+	assert.False(t, paginator.HasNext())
+	assert.Equal(t, 3, testServer.CallCount.Get(http.MethodGet, "/api/v1/search/users"))
+
+	_, err = client.SearchPaginator(users.SearchParams{Query: "jane", EndingBefore: "kp_3"})
+	assert.Error(t, err)
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/users/kp_1/feature_flags/seats", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "10", query.Get("value"))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.SetFeatureFlag(context.TODO(), "kp_1", "seats", featureflags.IntValue(10))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/users/kp_1/feature_flags/seats"))
+}
+
+func TestUpdateProperties(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/users/kp_1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"properties":{"plan_tier":"gold"}}`, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	err := client.UpdateProperties(context.TODO(), "kp_1", map[string]string{"plan_tier": "gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/users/kp_1/properties"))
+}
+
+func TestSetPassword(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	var bodies []string
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/users/kp_1/password", func(header http.Header, query url.Values, body []byte) (int, string) {
+		bodies = append(bodies, string(body))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+
+	bcryptHash := users.HashedPassword(users.HashingMethodBcrypt, "$2a$10$hash")
+	bcryptHash.IsTemporaryPassword = true
+	assert.NoError(t, client.SetPassword(context.TODO(), "kp_1", bcryptHash))
+
+	hashed := users.HashedPassword(users.HashingMethodSHA256, "5e884898")
+	hashed.Salt = "pepper"
+	hashed.SaltPosition = users.SaltPositionSuffix
+	assert.NoError(t, client.SetPassword(context.TODO(), "kp_1", hashed))
+
+	assert.Equal(t, []string{
+		`{"hashed_password":"$2a$10$hash","hashing_method":"bcrypt","is_temporary_password":true}`,
+		`{"hashed_password":"5e884898","hashing_method":"sha256","salt":"pepper","salt_position":"suffix"}`,
+	}, bodies)
+}
+
+func TestSetPlainPassword(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPut, "/api/v1/users/kp_1/password", func(header http.Header, query url.Values, body []byte) (int, string) {
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "bcrypt", payload["hashing_method"])
+		assert.Equal(t, true, payload["is_temporary_password"])
+
+		hash, _ := payload["hashed_password"].(string)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct horse battery staple")))
+		return http.StatusOK, `{"code":"OK"}`
+	})
+
+	params, err := users.PlainPassword("correct horse battery staple")
+	require.NoError(t, err)
+	params.IsTemporaryPassword = true
+	assert.NoError(t, client.SetPassword(context.TODO(), "kp_1", params))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPut, "/api/v1/users/kp_1/password"))
+
+	_, err = users.PlainPassword("")
+	assert.Error(t, err)
+}
+
+func TestSetPasswordInvalid(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+
+	salted := users.HashedPassword(users.HashingMethodBcrypt, "$2a$10$hash")
+	salted.Salt = "pepper"
+	salted.SaltPosition = users.SaltPositionPrefix
+
+	missingPosition := users.HashedPassword(users.HashingMethodMD5, "hash")
+	missingPosition.Salt = "pepper"
+
+	for _, params := range []users.SetPasswordParams{
+		users.HashedPassword(users.HashingMethodBcrypt, ""),
+		{HashedPassword: "$2a$10$hash"},
+		users.HashedPassword("argon2", "hash"),
+		salted,
+		missingPosition,
+	} {
+		assert.Error(t, client.SetPassword(context.TODO(), "kp_1", params))
+	}
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodPut, "/api/v1/users/kp_1/password"))
+}
+
+func TestGetMFAFactors(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/mfa", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","mfa":{"id":"mfa_1","type":"totp","name":"Authenticator","is_verified":true,"usage_count":3,"created_on":"2024-01-01T00:00:00Z"}}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_2/mfa", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK"}`
+	})
+
+	factors, err := client.GetMFAFactors(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	require.Len(t, factors, 1)
+	assert.Equal(t, users.MFAFactorTypeTOTP, factors[0].Type)
+	assert.True(t, factors[0].IsVerified)
+
+	factors, err = client.GetMFAFactors(context.TODO(), "kp_2")
+	assert.NoError(t, err)
+	assert.Empty(t, factors)
+}
+
+func TestResetMFAFactors(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	assert.NoError(t, client.ResetMFAFactor(context.TODO(), "kp_1", "mfa_1"))
+	assert.NoError(t, client.ResetMFAFactors(context.TODO(), "kp_1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/mfa/mfa_1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/mfa"))
+
+	assert.Error(t, client.ResetMFAFactor(context.TODO(), "kp_1", ""))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/mfa"))
+}
+
+func TestResetMFAFactorsByType(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/mfa", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","mfa":{"id":"mfa_1","type":"totp","name":"Authenticator","is_verified":true}}`
+	})
+
+	assert.NoError(t, client.ResetMFAFactorsByType(context.TODO(), "kp_1", users.MFAFactorTypeSMS))
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/mfa/mfa_1"))
+
+	assert.NoError(t, client.ResetMFAFactorsByType(context.TODO(), "kp_1", users.MFAFactorTypeTOTP))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/mfa/mfa_1"))
+
+	assert.Error(t, client.ResetMFAFactorsByType(context.TODO(), "kp_1", "pigeon"))
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/mfa"))
+}
+
+func TestListSessions(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/sessions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("starting_after") == "" {
+			return http.StatusOK, `{"code":"OK","has_more":true,"sessions":[{"session_id":"s_1","user_id":"kp_1","client_id":"c_1","last_ip_address":"10.0.0.1","last_user_agent":"curl","started_on":"2024-01-01T00:00:00Z","updated_on":"2024-01-02T00:00:00Z"}]}`
+		}
+
+		assert.Equal(t, "s_1", query.Get("starting_after"))
+		return http.StatusOK, `{"code":"OK","has_more":false,"sessions":[{"session_id":"s_2","user_id":"kp_1"}]}`
+	})
+
+	sessions, err := client.ListSessions(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "s_2", sessions[1].ID)
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/sessions"))
+	assert.Equal(t, "s_1", sessions[0].ID)
+	assert.Equal(t, "10.0.0.1", sessions[0].LastIPAddress)
+	assert.Equal(t, 2, sessions[0].UpdatedOn.Day())
+}
+
+func TestLock(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodPatch, "/api/v1/user", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "kp_1", query.Get("id"))
+		assert.Equal(t, `{"is_suspended":true}`, string(body))
+		return http.StatusOK, `{"id":"kp_1","is_suspended":true}`
+	})
+
+	user, err := client.Lock(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	require.NotNil(t, user)
+	assert.True(t, user.IsSuspended)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/users/kp_1/sessions"))
+}
+
+func TestRefreshClaims(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	assert.NoError(t, client.RefreshClaims(context.TODO(), "kp_1"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/users/kp_1/refresh_claims"))
+}
+
+func TestGetMemberships(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/user", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "kp_1", query.Get("id"))
+		assert.Equal(t, "organizations", query.Get("expand"))
+		return http.StatusOK, `{"id":"kp_1","organizations":["org_1","org_2"]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/users/kp_1/roles", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","roles":[{"id":"role_1","key":"admin","name":"Admin"}]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/users/kp_1/permissions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK","permissions":[{"id":"perm_1","key":"billing:read","name":"Read billing","roles":[{"id":"role_1","key":"admin"}]}]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_2/users/kp_1/roles", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK"}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_2/users/kp_1/permissions", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"code":"OK"}`
+	})
+
+	memberships, err := client.GetMemberships(context.TODO(), "kp_1", 2)
+	assert.NoError(t, err)
+	require.Len(t, memberships, 2)
+	assert.Equal(t, "org_1", memberships[0].OrgCode)
+	assert.Equal(t, []string{"admin"}, memberships[0].RoleKeys())
+	assert.True(t, memberships[0].HasPermission("billing:read"))
+	assert.Equal(t, "org_2", memberships[1].OrgCode)
+	assert.Empty(t, memberships[1].Roles)
+	assert.False(t, memberships[1].HasPermission("billing:read"))
+}
+
+func TestGetMembershipsFails(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/user", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusOK, `{"id":"kp_1","organizations":["org_1"]}`
+	})
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/organizations/org_1/users/kp_1/roles", func(header http.Header, query url.Values, body []byte) (int, string) {
+		return http.StatusInternalServerError, `{"errors":[{"code":"INTERNAL_ERROR","message":"internal error"}]}`
+	})
+
+	memberships, err := client.GetMemberships(context.TODO(), "kp_1", 0)
+	assert.Error(t, err)
+	assert.Nil(t, memberships)
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodGet, "/api/v1/organizations/org_1/users/kp_1/permissions"))
+}
+
+func TestCreateWithIdentities(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, "", "/api/v1/user", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if len(body) == 0 {
+			assert.Equal(t, "kp_1", query.Get("id"))
+			return http.StatusOK, `{"id":"kp_1","provided_id":"legacy_1","preferred_email":"jane@example.com","first_name":"Jane","last_name":"Doe"}`
+		}
+
+		assert.Equal(t, `{"profile":{"given_name":"Jane","family_name":"Doe","email":"jane@example.com","provided_id":"legacy_1"},"identities":[`+
+			`{"type":"email","is_verified":true,"details":{"email":"jane@example.com"}},`+
+			`{"type":"phone","details":{"phone":"412345678","phone_country_id":"au"}},`+
+			`{"type":"phone","is_verified":true,"provided_id":"legacy_phone_1","details":{"phone":"55251234","phone_country_id":"am"}},`+
+			`{"type":"username","details":{"username":"jane"}},`+
+			`{"type":"social","is_verified":true,"provided_id":"google_1","details":{"email":"jane@example.com","connection_id":"conn_google"}},`+
+			`{"type":"enterprise","details":{"email":"jane@corp.example.com","connection_id":"conn_saml"}}]}`, string(body))
+		return http.StatusOK, `{"id":"kp_1","created":true}`
+	})
+
+	mobile, err := users.PhoneIdentity("+61412345678", false)
+	require.NoError(t, err)
+
+	google := users.SocialIdentity("conn_google", "jane@example.com", true)
+	google.ProvidedID = "google_1"
+
+	user, err := client.Create(context.TODO(), users.CreateParams{
+		Profile: users.Profile{
+			GivenName:  "Jane",
+			FamilyName: "Doe",
+			Email:      "jane@example.com",
+			ProvidedID: "legacy_1",
+		},
+		Identities: []users.CreateIdentity{
+			users.EmailIdentity("jane@example.com", true),
+			mobile,
+			{
+				Type:       users.IdentityTypePhone,
+				IsVerified: true,
+				ProvidedID: "legacy_phone_1",
+				Details:    users.CreateIdentityDetails{Phone: "55251234", PhoneCountryID: "am"},
+			},
+			users.UsernameIdentity("jane"),
+			google,
+			users.EnterpriseIdentity("conn_saml", "jane@corp.example.com", false),
+		},
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, "legacy_1", user.ProvidedID)
+	assert.Equal(t, "jane@example.com", user.PreferredEmail)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/user"))
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/user"))
+}
+
+func TestCreateInvalidIdentities(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+
+	_, err := users.PhoneIdentity("12345", true)
+	assert.Error(t, err)
+
+	for _, identity := range []users.CreateIdentity{
+		{Type: "carrier_pigeon"},
+		users.EmailIdentity("", true),
+		{Type: users.IdentityTypePhone, Details: users.CreateIdentityDetails{Phone: "12345"}},
+		users.SocialIdentity("", "jane@example.com", true),
+	} {
+		_, err := client.Create(context.TODO(), users.CreateParams{
+			Profile:    users.Profile{Email: "jane@example.com"},
+			Identities: []users.CreateIdentity{identity},
+		})
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 0, testServer.CallCount.Get(http.MethodPost, "/api/v1/user"))
+}
+
+func TestGetIdentities(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/identities", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("starting_after") == "" {
+			return http.StatusOK, `{"code":"OK","has_more":true,"identities":[{"id":"id_1","type":"phone","name":"412345678","details":{"phone_country_id":"au"}}]}`
+		}
+
+		assert.Equal(t, "id_1", query.Get("starting_after"))
+		return http.StatusOK, `{"code":"OK","has_more":false,"identities":[{"id":"id_2","type":"username","name":"jane"}]}`
+	})
+
+	identities, err := client.GetIdentities(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	require.Len(t, identities, 2)
+	assert.Equal(t, users.IdentityTypePhone, identities[0].Type)
+
+	number, ok := identities[0].Phone()
+	assert.True(t, ok)
+	assert.Equal(t, "+61412345678", number)
+	_, ok = identities[0].Email()
+	assert.False(t, ok)
+
+	username, ok := identities[1].Username()
+	assert.True(t, ok)
+	assert.Equal(t, "jane", username)
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/identities"))
+}
+
+func TestListIdentitiesPaginator(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/identities", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, "id_1", query.Get("starting_after"))
+		assert.Equal(t, []string{"id_1"}, query["starting_after"])
+		assert.Empty(t, query.Get("ending_before"))
+		return http.StatusOK, `{"code":"OK","has_more":false,"identities":[{"id":"id_2","type":"username","name":"jane"}]}`
+	})
+
+	paginator, err := client.ListIdentitiesPaginator("kp_1", users.ListIdentitiesParams{StartingAfter: "id_1"})
+	require.NoError(t, err)
+
+	page, err := paginator.Next(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "id_2", page[0].ID)
+	assert.False(t, paginator.HasNext())
+
+	_, err = client.ListIdentitiesPaginator("kp_1", users.ListIdentitiesParams{EndingBefore: "id_2"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/identities"))
+}
+
+func TestGetProperties(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := users.New(client.New(context.TODO(), nil))
+	testServer.HandleAuthenticated(t, http.MethodGet, "/api/v1/users/kp_1/properties", func(header http.Header, query url.Values, body []byte) (int, string) {
+		if query.Get("next_token") == "" {
+			return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_1","key":"crm_id","value":"crm_1"}],"next_token":"page_2"}`
+		}
+
+		assert.Equal(t, "page_2", query.Get("next_token"))
+		return http.StatusOK, `{"code":"OK","properties":[{"id":"prop_2","key":"plan_tier","value":"gold"}]}`
+	})
+	values, err := client.GetProperties(context.TODO(), "kp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"crm_id": "crm_1", "plan_tier": "gold"}, values.Map())
+	assert.Equal(t, 2, testServer.CallCount.Get(http.MethodGet, "/api/v1/users/kp_1/properties"))
+}
@@ -1,6 +1,16 @@
 package users
 
-import "time"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/internal/enum"
+	"github.com/nxt-fwd/kinde-go/internal/phone"
+	"golang.org/x/crypto/bcrypt"
+)
 
 type User struct {
 	ID             string     `json:"id"`
@@ -23,10 +33,10 @@ type ListResponse struct {
 }
 
 type CreateParams struct {
-	Profile    Profile    `json:"profile"`
-	Identities []Identity `json:"identities,omitempty"`
-	Password   string     `json:"password,omitempty"`
-	OrgCode    string     `json:"org_code,omitempty"`
+	Profile    Profile          `json:"profile"`
+	Identities []CreateIdentity `json:"identities,omitempty"`
+	Password   string           `json:"password,omitempty"`
+	OrgCode    string           `json:"org_code,omitempty"`
 }
 
 type Profile struct {
@@ -38,15 +48,163 @@ type Profile struct {
 
 type Identity struct {
 	ID          string            `json:"id"`
-	Type        string            `json:"type"`
+	Type        IdentityType      `json:"type"`
 	Name        string            `json:"name"`
 	CreatedOn   time.Time         `json:"created_on"`
 	IsConfirmed *bool             `json:"is_confirmed"`
+	IsPrimary   bool              `json:"is_primary"`
 	TotalLogins int               `json:"total_logins"`
 	LastLoginOn *time.Time        `json:"last_login_on"`
 	Details     map[string]string `json:"details"`
 }
 
+// detail returns the named detail, falling back to the identity name which
+// holds the identity value
+func (i Identity) detail(key string) string {
+	if value := i.Details[key]; value != "" {
+		return value
+	}
+
+	return i.Name
+}
+
+// Email returns the email address of an email, social or enterprise identity
+func (i Identity) Email() (string, bool) {
+	switch i.Type {
+	case IdentityTypeEmail, IdentityTypeSocial, IdentityTypeEnterprise:
+		email := i.detail("email")
+		return email, email != ""
+	}
+
+	return "", false
+}
+
+// Phone returns the phone number of a phone identity in international format
+// when it can be parsed, otherwise as returned by kinde
+func (i Identity) Phone() (string, bool) {
+	if i.Type != IdentityTypePhone {
+		return "", false
+	}
+
+	number := i.detail("phone")
+	if countryID := i.Details["phone_country_id"]; countryID != "" {
+		if formatted, err := phone.FormatNumber(number, countryID); err == nil {
+			return formatted, true
+		}
+	}
+
+	return number, number != ""
+}
+
+// Username returns the username of a username identity
+func (i Identity) Username() (string, bool) {
+	if i.Type != IdentityTypeUsername {
+		return "", false
+	}
+
+	username := i.detail("username")
+	return username, username != ""
+}
+
+// ConnectionDetails are the details of a social or enterprise identity
+type ConnectionDetails struct {
+	ConnectionID string
+	Provider     string
+	Email        string
+}
+
+// Connection returns the details of a social or enterprise identity
+func (i Identity) Connection() (ConnectionDetails, bool) {
+	switch i.Type {
+	case IdentityTypeSocial, IdentityTypeEnterprise:
+		email, _ := i.Email()
+		return ConnectionDetails{
+			ConnectionID: i.Details["connection_id"],
+			Provider:     i.Details["provider"],
+			Email:        email,
+		}, true
+	}
+
+	return ConnectionDetails{}, false
+}
+
+// Matches reports whether the identity has the given type and value, emails
+// and usernames are compared case insensitively and phone numbers in
+// international format
+func (i Identity) Matches(t IdentityType, value string) bool {
+	if i.Type != t {
+		return false
+	}
+
+	switch t {
+	case IdentityTypePhone:
+		number, _ := i.Phone()
+		return normalizePhone(number) == normalizePhone(value)
+	case IdentityTypeUsername:
+		username, _ := i.Username()
+		return strings.EqualFold(username, value)
+	default:
+		email, _ := i.Email()
+		return strings.EqualFold(email, value) || strings.EqualFold(i.Name, value)
+	}
+}
+
+// normalizePhone converts a full phone number to international format without
+// separators, leaving numbers that cannot be parsed unchanged
+func normalizePhone(number string) string {
+	localNumber, countryID, err := phone.ParseNumber(number)
+	if err != nil {
+		return number
+	}
+
+	formatted, err := phone.FormatNumber(localNumber, countryID)
+	if err != nil {
+		return number
+	}
+
+	return formatted
+}
+
+type ListIdentitiesParams struct {
+	PageSize      int
+	StartingAfter string
+	EndingBefore  string
+}
+
+func (p ListIdentitiesParams) query() url.Values {
+	query := url.Values{}
+	if p.PageSize > 0 {
+		query.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+
+	if p.StartingAfter != "" {
+		query.Set("starting_after", p.StartingAfter)
+	}
+
+	if p.EndingBefore != "" {
+		query.Set("ending_before", p.EndingBefore)
+	}
+
+	return query
+}
+
+type ListIdentitiesResponse struct {
+	Code       string     `json:"code"`
+	Message    string     `json:"message"`
+	HasMore    bool       `json:"has_more"`
+	Identities []Identity `json:"identities"`
+}
+
+func (r ListIdentitiesResponse) GetNextToken() string {
+	if !r.HasMore || len(r.Identities) == 0 {
+		return ""
+	}
+
+	return r.Identities[len(r.Identities)-1].ID
+}
+
+func (r ListIdentitiesResponse) GetData() []Identity { return r.Identities }
+
 type CreateResponse struct {
 	ID         string     `json:"id"`
 	Created    bool       `json:"created"`
@@ -91,6 +249,142 @@ const (
 	IdentityTypeSocial     IdentityType = "social"
 )
 
+var _ enum.Enum[IdentityType] = (*IdentityType)(nil)
+
+func (t IdentityType) Options() []IdentityType {
+	return []IdentityType{
+		IdentityTypeEmail,
+		IdentityTypeUsername,
+		IdentityTypePhone,
+		IdentityTypeEnterprise,
+		IdentityTypeSocial,
+	}
+}
+
+func (t IdentityType) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// CreateIdentity is an identity created together with a user, use the
+// EmailIdentity, PhoneIdentity, UsernameIdentity, SocialIdentity and
+// EnterpriseIdentity constructors to build one
+type CreateIdentity struct {
+	Type       IdentityType `json:"type"`
+	IsVerified bool         `json:"is_verified,omitempty"`
+	// ProvidedID is an external id for the identity, such as the id in the
+	// system the user is migrated from
+	ProvidedID string                `json:"provided_id,omitempty"`
+	Details    CreateIdentityDetails `json:"details"`
+}
+
+type CreateIdentityDetails struct {
+	Email          string `json:"email,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	PhoneCountryID string `json:"phone_country_id,omitempty"`
+	Username       string `json:"username,omitempty"`
+	ConnectionID   string `json:"connection_id,omitempty"`
+}
+
+func EmailIdentity(email string, isVerified bool) CreateIdentity {
+	return CreateIdentity{
+		Type:       IdentityTypeEmail,
+		IsVerified: isVerified,
+		Details:    CreateIdentityDetails{Email: email},
+	}
+}
+
+// PhoneIdentity creates a phone identity from a full international format
+// phone number
+func PhoneIdentity(fullPhoneNumber string, isVerified bool) (CreateIdentity, error) {
+	localNumber, countryID, err := phone.ParseNumber(fullPhoneNumber)
+	if err != nil {
+		return CreateIdentity{}, fmt.Errorf("invalid phone number: %w", err)
+	}
+
+	return CreateIdentity{
+		Type:       IdentityTypePhone,
+		IsVerified: isVerified,
+		Details: CreateIdentityDetails{
+			Phone:          localNumber,
+			PhoneCountryID: countryID,
+		},
+	}, nil
+}
+
+func UsernameIdentity(username string) CreateIdentity {
+	return CreateIdentity{
+		Type:    IdentityTypeUsername,
+		Details: CreateIdentityDetails{Username: username},
+	}
+}
+
+// SocialIdentity links a user to a social connection by the email the
+// provider reports for them
+func SocialIdentity(connectionID string, email string, isVerified bool) CreateIdentity {
+	return CreateIdentity{
+		Type:       IdentityTypeSocial,
+		IsVerified: isVerified,
+		Details: CreateIdentityDetails{
+			Email:        email,
+			ConnectionID: connectionID,
+		},
+	}
+}
+
+// EnterpriseIdentity links a user to an enterprise connection by the email the
+// identity provider reports for them
+func EnterpriseIdentity(connectionID string, email string, isVerified bool) CreateIdentity {
+	return CreateIdentity{
+		Type:       IdentityTypeEnterprise,
+		IsVerified: isVerified,
+		Details: CreateIdentityDetails{
+			Email:        email,
+			ConnectionID: connectionID,
+		},
+	}
+}
+
+// normalize validates the identity and converts phone numbers to the local
+// number and country id expected by kinde
+func (i CreateIdentity) normalize() (CreateIdentity, error) {
+	if err := i.Type.Valid(); err != nil {
+		return i, fmt.Errorf("invalid identity type: %w", err)
+	}
+
+	switch i.Type {
+	case IdentityTypeEmail:
+		if i.Details.Email == "" {
+			return i, fmt.Errorf("email identity requires an email")
+		}
+	case IdentityTypeUsername:
+		if i.Details.Username == "" {
+			return i, fmt.Errorf("username identity requires a username")
+		}
+	case IdentityTypePhone:
+		fullNumber := i.Details.Phone
+		if i.Details.PhoneCountryID != "" {
+			formatted, err := phone.FormatNumber(i.Details.Phone, i.Details.PhoneCountryID)
+			if err != nil {
+				return i, fmt.Errorf("invalid phone number components: %w", err)
+			}
+			fullNumber = formatted
+		}
+
+		normalized, err := PhoneIdentity(fullNumber, i.IsVerified)
+		if err != nil {
+			return i, err
+		}
+		normalized.ProvidedID = i.ProvidedID
+		return normalized, nil
+	case IdentityTypeSocial, IdentityTypeEnterprise:
+		if i.Details.ConnectionID == "" || i.Details.Email == "" {
+			return i, fmt.Errorf("%s identity requires a connection id and an email", i.Type)
+		}
+	}
+
+	return i, nil
+}
+
 type AddIdentityParams struct {
 	Value          string       `json:"value"`
 	Type           IdentityType `json:"type"`
@@ -103,3 +397,281 @@ type AddIdentityResponse struct {
 	Message  string   `json:"message"`
 	Identity Identity `json:"identity"`
 }
+
+var _ enum.Enum[SearchExpand] = (*SearchExpand)(nil)
+
+type SearchExpand string
+
+const (
+	SearchExpandOrganizations SearchExpand = "organizations"
+	SearchExpandIdentities    SearchExpand = "identities"
+)
+
+func (t SearchExpand) Options() []SearchExpand {
+	return []SearchExpand{
+		SearchExpandOrganizations,
+		SearchExpandIdentities,
+	}
+}
+
+func (t SearchExpand) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+type SearchParams struct {
+	// Query matches against partial names, emails and usernames
+	Query string
+	// Properties filters users by custom property values, keyed by property key
+	Properties    map[string]string
+	Expand        []SearchExpand
+	PageSize      int
+	StartingAfter string
+	EndingBefore  string
+}
+
+type SearchIdentity struct {
+	Type     string `json:"type"`
+	Identity string `json:"identity"`
+}
+
+type SearchResult struct {
+	ID            string            `json:"id"`
+	ProvidedID    string            `json:"provided_id,omitempty"`
+	Email         string            `json:"email"`
+	Username      string            `json:"username"`
+	LastName      string            `json:"last_name"`
+	FirstName     string            `json:"first_name"`
+	IsSuspended   bool              `json:"is_suspended"`
+	Picture       string            `json:"picture,omitempty"`
+	TotalSignIns  int               `json:"total_sign_ins"`
+	FailedSignIns int               `json:"failed_sign_ins"`
+	LastSignedIn  *time.Time        `json:"last_signed_in,omitempty"`
+	CreatedOn     time.Time         `json:"created_on"`
+	Organizations []string          `json:"organizations,omitempty"`
+	Identities    []SearchIdentity  `json:"identities,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+}
+
+type SearchResponse struct {
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Results []SearchResult `json:"results"`
+}
+
+// the search endpoint is cursor based, the id of the last result is used as
+// the starting_after value for the following page. The response does not say
+// whether more results exist, SearchResultsPaginator stops on short pages.
+func (r SearchResponse) GetNextToken() string {
+	if len(r.Results) == 0 {
+		return ""
+	}
+
+	return r.Results[len(r.Results)-1].ID
+}
+
+func (r SearchResponse) GetData() []SearchResult { return r.Results }
+
+var _ enum.Enum[HashingMethod] = (*HashingMethod)(nil)
+
+type HashingMethod string
+
+const (
+	HashingMethodBcrypt HashingMethod = "bcrypt"
+	HashingMethodCrypt  HashingMethod = "crypt"
+	HashingMethodMD5    HashingMethod = "md5"
+	HashingMethodSHA256 HashingMethod = "sha256"
+)
+
+func (t HashingMethod) Options() []HashingMethod {
+	return []HashingMethod{
+		HashingMethodBcrypt,
+		HashingMethodCrypt,
+		HashingMethodMD5,
+		HashingMethodSHA256,
+	}
+}
+
+func (t HashingMethod) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+var _ enum.Enum[SaltPosition] = (*SaltPosition)(nil)
+
+type SaltPosition string
+
+const (
+	SaltPositionPrefix SaltPosition = "prefix"
+	SaltPositionSuffix SaltPosition = "suffix"
+)
+
+func (t SaltPosition) Options() []SaltPosition {
+	return []SaltPosition{
+		SaltPositionPrefix,
+		SaltPositionSuffix,
+	}
+}
+
+func (t SaltPosition) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// SetPasswordParams sets a user's password from a hash, use PlainPassword or
+// HashedPassword to build it. Kinde only accepts hashed passwords, the hashing
+// method is always required.
+type SetPasswordParams struct {
+	HashedPassword string        `json:"hashed_password"`
+	HashingMethod  HashingMethod `json:"hashing_method"`
+	Salt           string        `json:"salt,omitempty"`
+	SaltPosition   SaltPosition  `json:"salt_position,omitempty"`
+	// IsTemporaryPassword forces the user to reset the password on next sign in
+	IsTemporaryPassword bool `json:"is_temporary_password,omitempty"`
+}
+
+// PlainPassword hashes a plain text password with bcrypt before it is sent to
+// kinde
+func PlainPassword(password string) (SetPasswordParams, error) {
+	if password == "" {
+		return SetPasswordParams{}, fmt.Errorf("password is required")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return SetPasswordParams{}, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return HashedPassword(HashingMethodBcrypt, string(hash)), nil
+}
+
+// HashedPassword imports a password hash, salt and salt position are only
+// needed for salted md5 and sha256 hashes and can be set on the result
+func HashedPassword(method HashingMethod, hash string) SetPasswordParams {
+	return SetPasswordParams{HashedPassword: hash, HashingMethod: method}
+}
+
+func (p SetPasswordParams) validate() error {
+	if p.HashedPassword == "" {
+		return fmt.Errorf("hashed password is required")
+	}
+
+	if p.HashingMethod == "" {
+		return fmt.Errorf("hashing method is required")
+	}
+
+	if err := p.HashingMethod.Valid(); err != nil {
+		return fmt.Errorf("invalid hashing method: %w", err)
+	}
+
+	if p.Salt == "" {
+		if p.SaltPosition != "" {
+			return fmt.Errorf("salt position requires a salt")
+		}
+		return nil
+	}
+
+	switch p.HashingMethod {
+	case HashingMethodBcrypt, HashingMethodCrypt:
+		return fmt.Errorf("%s hashes embed their salt", p.HashingMethod)
+	}
+
+	if err := p.SaltPosition.Valid(); err != nil {
+		return fmt.Errorf("invalid salt position: %w", err)
+	}
+
+	return nil
+}
+
+var _ enum.Enum[MFAFactorType] = (*MFAFactorType)(nil)
+
+type MFAFactorType string
+
+const (
+	MFAFactorTypeTOTP  MFAFactorType = "totp"
+	MFAFactorTypeSMS   MFAFactorType = "sms"
+	MFAFactorTypeEmail MFAFactorType = "email"
+)
+
+func (t MFAFactorType) Options() []MFAFactorType {
+	return []MFAFactorType{
+		MFAFactorTypeTOTP,
+		MFAFactorTypeSMS,
+		MFAFactorTypeEmail,
+	}
+}
+
+func (t MFAFactorType) Valid() error {
+	return enum.Valid(t.Options(), t)
+}
+
+// MFAFactor is an mfa factor a user has enrolled
+type MFAFactor struct {
+	ID         string        `json:"id"`
+	Type       MFAFactorType `json:"type"`
+	Name       string        `json:"name"`
+	IsVerified bool          `json:"is_verified"`
+	UsageCount int           `json:"usage_count"`
+	CreatedOn  time.Time     `json:"created_on"`
+	LastUsedOn *time.Time    `json:"last_used_on,omitempty"`
+}
+
+// Session is an active session of a user
+type Session struct {
+	ID               string    `json:"session_id"`
+	UserID           string    `json:"user_id"`
+	OrgCode          string    `json:"org_code,omitempty"`
+	ClientID         string    `json:"client_id"`
+	ConnectionID     string    `json:"connection_id,omitempty"`
+	InitialIPAddress string    `json:"initial_ip_address"`
+	InitialUserAgent string    `json:"initial_user_agent"`
+	LastIPAddress    string    `json:"last_ip_address"`
+	LastUserAgent    string    `json:"last_user_agent"`
+	StartedOn        time.Time `json:"started_on"`
+	UpdatedOn        time.Time `json:"updated_on"`
+	ExpiresOn        time.Time `json:"expires_on"`
+}
+
+type ListSessionsResponse struct {
+	Code     string    `json:"code"`
+	Message  string    `json:"message"`
+	HasMore  bool      `json:"has_more"`
+	Sessions []Session `json:"sessions"`
+}
+
+func (r ListSessionsResponse) GetNextToken() string {
+	if !r.HasMore || len(r.Sessions) == 0 {
+		return ""
+	}
+
+	return r.Sessions[len(r.Sessions)-1].ID
+}
+
+func (r ListSessionsResponse) GetData() []Session { return r.Sessions }
+
+// Membership is a user's membership of an organization with the roles and
+// permissions they hold in it
+type Membership struct {
+	OrgCode     string                     `json:"org_code"`
+	Roles       []organizations.Role       `json:"roles"`
+	Permissions []organizations.Permission `json:"permissions"`
+}
+
+// RoleKeys returns the keys of the roles held in the organization
+func (m Membership) RoleKeys() []string {
+	keys := make([]string, 0, len(m.Roles))
+	for _, role := range m.Roles {
+		keys = append(keys, role.Key)
+	}
+
+	return keys
+}
+
+// HasPermission reports whether the permission key is held in the
+// organization, directly or through a role
+func (m Membership) HasPermission(key string) bool {
+	for _, permission := range m.Permissions {
+		if permission.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
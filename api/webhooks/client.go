@@ -0,0 +1,130 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nxt-fwd/kinde-go/internal/client"
+)
+
+type Client struct {
+	client.Client
+}
+
+func New(client client.Client) *Client {
+	return &Client{client}
+}
+
+type ListResponse struct {
+	Code     string    `json:"code"`
+	Message  string    `json:"message"`
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// https://kinde.com/api/docs/#list-webhooks
+func (c *Client) List(ctx context.Context) ([]Webhook, error) {
+	endpoint := "/api/v1/webhooks"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Webhooks, nil
+}
+
+type CreateParams struct {
+	Name        string      `json:"name"`
+	Endpoint    string      `json:"endpoint"`
+	Description string      `json:"description,omitempty"`
+	EventTypes  []EventType `json:"event_types"`
+}
+
+type CreateResponse struct {
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Webhook Webhook `json:"webhook"`
+}
+
+// https://kinde.com/api/docs/#create-a-webhook
+//
+// note: only ID and endpoint will be populated
+func (c *Client) Create(ctx context.Context, params CreateParams) (*Webhook, error) {
+	endpoint := "/api/v1/webhooks"
+	req, err := c.NewRequest(ctx, http.MethodPost, endpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Webhook, nil
+}
+
+type UpdateParams struct {
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description,omitempty"`
+	EventTypes  []EventType `json:"event_types,omitempty"`
+}
+
+// https://kinde.com/api/docs/#update-a-webhook
+//
+// note: the endpoint of a webhook cannot be changed
+func (c *Client) Update(ctx context.Context, id string, params UpdateParams) error {
+	endpoint := fmt.Sprintf("/api/v1/webhooks/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// https://kinde.com/api/docs/#delete-webhook
+func (c *Client) Delete(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/api/v1/webhooks/%s", id)
+	req, err := c.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DoRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type ListEventTypesResponse struct {
+	Code       string          `json:"code"`
+	Message    string          `json:"message"`
+	EventTypes []EventTypeInfo `json:"event_types"`
+}
+
+// https://kinde.com/api/docs/#list-event-types
+func (c *Client) ListEventTypes(ctx context.Context) ([]EventTypeInfo, error) {
+	endpoint := "/api/v1/event_types"
+	req, err := c.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListEventTypesResponse
+	if err := c.DoRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.EventTypes, nil
+}
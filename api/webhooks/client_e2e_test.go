@@ -0,0 +1,51 @@
+//go:build e2e
+// +build e2e
+
+package webhooks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE2EListEventTypes(t *testing.T) {
+	client := webhooks.New(testutil.DefaultE2EClient(t))
+	res, err := client.ListEventTypes(context.TODO())
+	assert.NoError(t, err)
+	t.Logf("found %d event types", len(res))
+}
+
+func TestE2ECreateUpdateDelete(t *testing.T) {
+	client := webhooks.New(testutil.DefaultE2EClient(t))
+	tempID := fmt.Sprintf("test-%d", time.Now().UnixMilli())
+
+	res, err := client.Create(context.TODO(), webhooks.CreateParams{
+		Name:       tempID,
+		Endpoint:   fmt.Sprintf("https://example.com/%s", tempID),
+		EventTypes: []webhooks.EventType{webhooks.EventTypeUserCreated},
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Logf("created test webhook: %s\n", res.ID)
+
+	err = client.Update(context.TODO(), res.ID, webhooks.UpdateParams{
+		Name:       tempID + "-updated",
+		EventTypes: []webhooks.EventType{webhooks.EventTypeUserCreated, webhooks.EventTypeUserUpdated},
+	})
+	assert.NoError(t, err)
+
+	t.Logf("updated test webhook: %s\n", res.ID)
+
+	err = client.Delete(context.TODO(), res.ID)
+	assert.NoError(t, err)
+
+	t.Logf("deleted test webhook: %s\n", res.ID)
+}
@@ -0,0 +1,56 @@
+package webhooks_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/client"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := webhooks.New(client.New(context.TODO(), nil))
+	_, _ = client.List(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/webhooks"))
+}
+
+func TestCreate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := webhooks.New(client.New(context.TODO(), nil))
+	testServer.Handle(t, http.MethodPost, "/api/v1/webhooks", func(header http.Header, query url.Values, body []byte) (int, string) {
+		assert.Equal(t, `{"name":"name","endpoint":"https://example.com/hook","event_types":["user.created"]}`, string(body))
+		return http.StatusOK, `{"code":"OK","webhook":{"id":"id","endpoint":"https://example.com/hook"}}`
+	})
+	_, _ = client.Create(context.TODO(), webhooks.CreateParams{
+		Name:       "name",
+		Endpoint:   "https://example.com/hook",
+		EventTypes: []webhooks.EventType{webhooks.EventTypeUserCreated},
+	})
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPost, "/api/v1/webhooks"))
+}
+
+func TestUpdate(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := webhooks.New(client.New(context.TODO(), nil))
+	_ = client.Update(context.TODO(), "1", webhooks.UpdateParams{})
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodPatch, "/api/v1/webhooks/1"))
+}
+
+func TestDelete(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := webhooks.New(client.New(context.TODO(), nil))
+	_ = client.Delete(context.TODO(), "1")
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodDelete, "/api/v1/webhooks/1"))
+}
+
+func TestListEventTypes(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	client := webhooks.New(client.New(context.TODO(), nil))
+	_, _ = client.ListEventTypes(context.TODO())
+	assert.Equal(t, 1, testServer.CallCount.Get(http.MethodGet, "/api/v1/event_types"))
+}
@@ -0,0 +1,50 @@
+package webhooks
+
+import "time"
+
+// https://kinde.com/api/docs/#kinde-management-api-webhooks
+type Webhook struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Endpoint    string      `json:"endpoint"`
+	Description string      `json:"description,omitempty"`
+	EventTypes  []EventType `json:"event_types"`
+	CreatedOn   time.Time   `json:"created_on,omitempty"`
+}
+
+// EventType is the code of an event a webhook can subscribe to
+type EventType string
+
+const (
+	EventTypeUserCreated              EventType = "user.created"
+	EventTypeUserUpdated              EventType = "user.updated"
+	EventTypeUserDeleted              EventType = "user.deleted"
+	EventTypeUserAuthenticated        EventType = "user.authenticated"
+	EventTypeUserAuthenticationFailed EventType = "user.authentication_failed"
+
+	EventTypeOrganizationCreated     EventType = "organization.created"
+	EventTypeOrganizationUpdated     EventType = "organization.updated"
+	EventTypeOrganizationDeleted     EventType = "organization.deleted"
+	EventTypeOrganizationUserAdded   EventType = "organization.user_added"
+	EventTypeOrganizationUserRemoved EventType = "organization.user_removed"
+
+	EventTypeRoleCreated  EventType = "role.created"
+	EventTypeRoleUpdated  EventType = "role.updated"
+	EventTypeRoleDeleted  EventType = "role.deleted"
+	EventTypeRoleAssigned EventType = "role.assigned"
+
+	EventTypePermissionCreated EventType = "permission.created"
+	EventTypePermissionUpdated EventType = "permission.updated"
+	EventTypePermissionDeleted EventType = "permission.deleted"
+
+	EventTypeAccessRequestCreated EventType = "access_request.created"
+)
+
+// EventTypeInfo describes an event type available for subscription
+type EventTypeInfo struct {
+	ID     string         `json:"id"`
+	Code   EventType      `json:"code"`
+	Name   string         `json:"name"`
+	Origin string         `json:"origin"`
+	Schema map[string]any `json:"schema,omitempty"`
+}
@@ -5,12 +5,18 @@ import (
 
 	"github.com/nxt-fwd/kinde-go/api/apis"
 	"github.com/nxt-fwd/kinde-go/api/applications"
+	"github.com/nxt-fwd/kinde-go/api/business"
+	"github.com/nxt-fwd/kinde-go/api/connections"
+	"github.com/nxt-fwd/kinde-go/api/environments"
+	"github.com/nxt-fwd/kinde-go/api/events"
+	"github.com/nxt-fwd/kinde-go/api/featureflags"
 	"github.com/nxt-fwd/kinde-go/api/identities"
 	"github.com/nxt-fwd/kinde-go/api/organizations"
 	"github.com/nxt-fwd/kinde-go/api/permissions"
+	"github.com/nxt-fwd/kinde-go/api/properties"
 	"github.com/nxt-fwd/kinde-go/api/roles"
 	"github.com/nxt-fwd/kinde-go/api/users"
-	"github.com/nxt-fwd/kinde-go/api/connections"
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
 	"github.com/nxt-fwd/kinde-go/internal/client"
 )
 
@@ -19,12 +25,18 @@ type Client struct {
 
 	APIs          *apis.Client
 	Applications  *applications.Client
+	Business      *business.Client
+	Environments  *environments.Client
+	Events        *events.Client
+	FeatureFlags  *featureflags.Client
 	Identities    *identities.Client
 	Organizations *organizations.Client
 	Permissions   *permissions.Client
+	Properties    *properties.Client
 	Roles         *roles.Client
 	Users         *users.Client
 	Connections   *connections.Client
+	Webhooks      *webhooks.Client
 }
 
 func New(ctx context.Context, options *ClientOptions) Client {
@@ -34,11 +46,17 @@ func New(ctx context.Context, options *ClientOptions) Client {
 		client:        client,
 		APIs:          apis.New(client),
 		Applications:  applications.New(client),
+		Business:      business.New(client),
+		Environments:  environments.New(client),
+		Events:        events.New(client),
+		FeatureFlags:  featureflags.New(client),
 		Identities:    identities.New(client),
 		Organizations: organizations.New(client),
 		Permissions:   permissions.New(client),
+		Properties:    properties.New(client),
 		Roles:         roles.New(client),
 		Users:         users.New(client),
 		Connections:   connections.New(client),
+		Webhooks:      webhooks.New(client),
 	}
 }
@@ -74,7 +74,17 @@ func (c *clientImpl) NewRequest(ctx context.Context, method string, path string,
 
 	// Create request
 	var buf io.Reader
-	if body != nil {
+	contentType := "application/json"
+	if multipartBody, ok := body.(*MultipartBody); ok {
+		encoded, multipartContentType, multipartErr := multipartBody.encode()
+		if multipartErr != nil {
+			return nil, fmt.Errorf("failed to encode multipart request body: %w", multipartErr)
+		}
+
+		buf = encoded
+		contentType = multipartContentType
+		c.logger.Logf("[Client.NewRequest] %s %s - multipart request body: %d bytes\n", method, path, encoded.Len())
+	} else if body != nil {
 		raw, jsonErr := json.Marshal(body)
 		if jsonErr != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", jsonErr)
@@ -93,7 +103,7 @@ func (c *clientImpl) NewRequest(ctx context.Context, method string, path string,
 	if token := c.options.GetAccessToken(); token != "" {
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	return req, nil
 }
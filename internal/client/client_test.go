@@ -2,7 +2,10 @@ package client_test
 
 import (
 	"context"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/nxt-fwd/kinde-go/internal/client"
@@ -192,3 +195,31 @@ func TestInvalidCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRequestMultipart(t *testing.T) {
+	testServer := testutil.NewTestServer(t, nil)
+	t.Cleanup(testServer.Server.Close)
+
+	c := client.New(context.TODO(), nil)
+	req, err := c.NewRequest(context.TODO(), http.MethodPost, "/upload", nil, &client.MultipartBody{
+		Fields: map[string]string{"name": "logo"},
+		Files: []client.MultipartFile{{
+			FieldName:   "logo",
+			FileName:    "logo.png",
+			ContentType: "image/png",
+			Content:     strings.NewReader("png"),
+		}},
+	})
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	form, err := multipart.NewReader(req.Body, params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logo"}, form.Value["name"])
+	require.Len(t, form.File["logo"], 1)
+	assert.Equal(t, "logo.png", form.File["logo"][0].Filename)
+	assert.Equal(t, "image/png", form.File["logo"][0].Header.Get("Content-Type"))
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// MultipartFile is a file part of a MultipartBody
+type MultipartFile struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Content     io.Reader
+}
+
+// MultipartBody is a request payload sent as multipart/form-data instead of
+// json, pass a *MultipartBody to NewRequest to upload files
+type MultipartBody struct {
+	Fields map[string]string
+	Files  []MultipartFile
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// encode writes the body and returns it along with its content type header
+func (b *MultipartBody) encode() (*bytes.Buffer, string, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	for name, value := range b.Fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, "", fmt.Errorf("failed to write field %s: %w", name, err)
+		}
+	}
+
+	for _, file := range b.Files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(file.FieldName), quoteEscaper.Replace(file.FileName)))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create file part %s: %w", file.FieldName, err)
+		}
+
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return nil, "", fmt.Errorf("failed to write file part %s: %w", file.FieldName, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart body: %w", err)
+	}
+
+	return buf, writer.FormDataContentType(), nil
+}
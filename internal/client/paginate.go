@@ -15,6 +15,14 @@ type Page[T any] interface {
 type PaginatorOptions struct {
 	Sort     string
 	PageSize int
+	// Query holds additional endpoint specific query parameters sent with
+	// every page request
+	Query url.Values
+	// TokenParam is the query parameter used to send the next token, defaults
+	// to next_token
+	TokenParam string
+	// NextToken resumes pagination from a token returned by a previous page
+	NextToken string
 }
 
 func NewPaginator[T any, P Page[T]](client Client, endpoint string, options PaginatorOptions) *Paginator[T, P] {
@@ -23,7 +31,7 @@ func NewPaginator[T any, P Page[T]](client Client, endpoint string, options Pagi
 		endpoint: endpoint,
 		options:  options,
 		first:    true,
-		token:    "",
+		token:    options.NextToken,
 	}
 }
 
@@ -43,6 +51,9 @@ func (p *Paginator[T, P]) Next(ctx context.Context) ([]T, error) {
 	p.first = false
 
 	query := url.Values{}
+	for key, values := range p.options.Query {
+		query[key] = append([]string(nil), values...)
+	}
 
 	if p.options.Sort != "" {
 		query.Set("sort", p.options.Sort)
@@ -53,7 +64,12 @@ func (p *Paginator[T, P]) Next(ctx context.Context) ([]T, error) {
 	}
 
 	if p.token != "" {
-		query.Set("next_token", p.token)
+		tokenParam := p.options.TokenParam
+		if tokenParam == "" {
+			tokenParam = "next_token"
+		}
+
+		query.Set(tokenParam, p.token)
 	}
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, p.endpoint, query, nil)
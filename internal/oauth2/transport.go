@@ -126,7 +126,10 @@ func (t *OAuth2Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
 	r.Header.Add("Accept", "application/json")
-	r.Header.Add("Content-Type", "application/json")
+	// requests may carry their own content type, e.g. multipart uploads
+	if r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", "application/json")
+	}
 
 	return t.Transport.RoundTrip(r)
 }
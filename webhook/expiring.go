@@ -0,0 +1,58 @@
+package webhook
+
+import "time"
+
+// expiringSet holds ids until they expire. Ids are added with a fixed
+// retention so expiries grow with insertion order, which lets pruning only
+// touch expired entries. It is not safe for concurrent use.
+type expiringSet struct {
+	expiries map[string]time.Time
+	order    []expiringID
+}
+
+type expiringID struct {
+	id     string
+	expiry time.Time
+}
+
+func newExpiringSet() *expiringSet {
+	return &expiringSet{expiries: map[string]time.Time{}}
+}
+
+func (s *expiringSet) add(id string, expiry time.Time) {
+	s.expiries[id] = expiry
+	s.order = append(s.order, expiringID{id: id, expiry: expiry})
+}
+
+func (s *expiringSet) has(id string) bool {
+	_, ok := s.expiries[id]
+	return ok
+}
+
+func (s *expiringSet) remove(id string) {
+	delete(s.expiries, id)
+}
+
+func (s *expiringSet) len() int {
+	return len(s.expiries)
+}
+
+// prune drops expired ids from the front of order
+func (s *expiringSet) prune(now time.Time) {
+	expired := 0
+	for _, entry := range s.order {
+		if !now.After(entry.expiry) {
+			break
+		}
+
+		// the id may have been removed and added again since
+		if expiry, ok := s.expiries[entry.id]; ok && expiry.Equal(entry.expiry) {
+			delete(s.expiries, entry.id)
+		}
+		expired++
+	}
+
+	// reslicing keeps pruning cheap, the backing array is reclaimed once
+	// append reallocates it
+	s.order = s.order[expired:]
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringSet(t *testing.T) {
+	s := newExpiringSet()
+	start := time.Now()
+
+	s.add("event_1", start.Add(time.Minute))
+	s.add("event_2", start.Add(2*time.Minute))
+
+	s.prune(start.Add(time.Minute + time.Second))
+	assert.False(t, s.has("event_1"))
+	assert.True(t, s.has("event_2"))
+	assert.Len(t, s.order, 1)
+
+	// a removed and added again id is kept until its latest expiry
+	s.remove("event_2")
+	s.add("event_2", start.Add(5*time.Minute))
+	s.prune(start.Add(3 * time.Minute))
+	assert.True(t, s.has("event_2"))
+	assert.Equal(t, 1, s.len())
+}
+
+func TestMarkSeen(t *testing.T) {
+	h := NewHandler(NewHandlerOptions().WithTolerance(time.Minute))
+	start := time.Now()
+
+	assert.True(t, h.markSeen("event_1", start))
+	assert.False(t, h.markSeen("event_1", start.Add(time.Minute)))
+
+	// ids are retained for twice the tolerance
+	assert.True(t, h.markSeen("event_1", start.Add(2*time.Minute+time.Second)))
+}
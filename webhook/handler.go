@@ -0,0 +1,320 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/events"
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/logger"
+)
+
+var (
+	ErrStaleEvent    = errors.New("stale webhook event")
+	ErrReplayedEvent = errors.New("replayed webhook event")
+)
+
+// maxBodySize caps the size of a webhook request body
+const maxBodySize = 1 << 20
+
+type HandlerOptions struct {
+	Domain    string
+	Tolerance time.Duration
+	// RetryWindow is how long an event whose handlers failed is accepted
+	// again, regardless of Tolerance, so kinde can redeliver it with backoff
+	RetryWindow time.Duration
+	KeysTTL     time.Duration
+	HTTPClient  *http.Client
+	Logger      logger.Logger
+}
+
+// NewHandlerOptions creates a new HandlerOptions instance.
+// The domain is loaded from the KINDE_DOMAIN environment variable, events older
+// or newer than 5 minutes are rejected, failed events can be redelivered for a
+// day and signing keys are cached for an hour.
+func NewHandlerOptions() *HandlerOptions {
+	return &HandlerOptions{
+		Domain:      os.Getenv("KINDE_DOMAIN"),
+		Tolerance:   5 * time.Minute,
+		RetryWindow: 24 * time.Hour,
+		KeysTTL:     time.Hour,
+		HTTPClient:  http.DefaultClient,
+		Logger:      logger.NoopLogger{},
+	}
+}
+
+func (o *HandlerOptions) WithDomain(domain string) *HandlerOptions {
+	o.Domain = domain
+	return o
+}
+
+func (o *HandlerOptions) WithTolerance(tolerance time.Duration) *HandlerOptions {
+	o.Tolerance = tolerance
+	return o
+}
+
+func (o *HandlerOptions) WithRetryWindow(window time.Duration) *HandlerOptions {
+	o.RetryWindow = window
+	return o
+}
+
+func (o *HandlerOptions) WithKeysTTL(ttl time.Duration) *HandlerOptions {
+	o.KeysTTL = ttl
+	return o
+}
+
+func (o *HandlerOptions) WithHTTPClient(client *http.Client) *HandlerOptions {
+	o.HTTPClient = client
+	return o
+}
+
+func (o *HandlerOptions) WithLogger(logger logger.Logger) *HandlerOptions {
+	o.Logger = logger
+	return o
+}
+
+// HandlerFunc processes a verified event, returning an error makes the handler
+// respond with a server error so kinde retries the delivery
+type HandlerFunc func(ctx context.Context, event events.Event) error
+
+// Handler is an http.Handler receiving kinde webhooks. Each request body is a
+// jwt signed with the business keys, it is verified, checked for staleness and
+// replays and then dispatched to the handlers registered for its event type.
+type Handler struct {
+	mu          sync.RWMutex
+	keys        *keyStore
+	tolerance   time.Duration
+	retryWindow time.Duration
+	logger      logger.Logger
+	handlers    map[webhooks.EventType][]HandlerFunc
+	fallback    HandlerFunc
+	// seen holds the ids of accepted events to reject replays
+	seen *expiringSet
+	// retries holds the ids of events whose handlers failed, they skip the
+	// staleness check when redelivered
+	retries *expiringSet
+	now     func() time.Time
+}
+
+func NewHandler(options *HandlerOptions) *Handler {
+	if options == nil {
+		options = NewHandlerOptions()
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	log := options.Logger
+	if log == nil {
+		log = logger.NoopLogger{}
+	}
+
+	endpoint := strings.TrimSuffix(options.Domain, "/") + "/.well-known/jwks.json"
+
+	return &Handler{
+		keys:        newKeyStore(httpClient, endpoint, options.KeysTTL),
+		tolerance:   options.Tolerance,
+		retryWindow: options.RetryWindow,
+		logger:      log,
+		handlers:    map[webhooks.EventType][]HandlerFunc{},
+		seen:        newExpiringSet(),
+		retries:     newExpiringSet(),
+		now:         time.Now,
+	}
+}
+
+// Handle registers fn for events of type t, several handlers can be registered
+// for the same type and run in registration order
+func (h *Handler) Handle(t webhooks.EventType, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[t] = append(h.handlers[t], fn)
+}
+
+// HandleDefault registers fn for events without a type specific handler
+func (h *Handler) HandleDefault(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.fallback = fn
+}
+
+// On registers a handler receiving the event payload decoded into T, for
+// example events.UserData for user.created events
+func On[T any](h *Handler, t webhooks.EventType, fn func(ctx context.Context, event events.Event, data T) error) {
+	h.Handle(t, func(ctx context.Context, event events.Event) error {
+		var data T
+		if err := event.DecodeData(&data); err != nil {
+			return err
+		}
+
+		return fn(ctx, event, data)
+	})
+}
+
+// Verify checks the signature of a webhook token and decodes it into an event,
+// rejecting stale and already seen events. Events redelivered after their
+// handlers failed are not checked for staleness.
+func (h *Handler) Verify(token string) (*events.Event, error) {
+	payload, err := h.keys.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var event events.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse event: %s", ErrInvalidToken, err)
+	}
+
+	if event.ID == "" || event.Type == "" {
+		return nil, fmt.Errorf("%w: missing event id or type", ErrInvalidToken)
+	}
+
+	now := h.now()
+	if h.tolerance > 0 && !h.isRetry(event.ID, now) {
+		age := now.Sub(event.Timestamp)
+		if age > h.tolerance || age < -h.tolerance {
+			return nil, fmt.Errorf("%w: %s was sent at %s", ErrStaleEvent, event.ID, event.Timestamp)
+		}
+	}
+
+	if !h.markSeen(event.ID, now) {
+		return nil, fmt.Errorf("%w: %s", ErrReplayedEvent, event.ID)
+	}
+
+	return &event, nil
+}
+
+// markSeen records an event id, returning false when it was already seen.
+// Ids are forgotten once they could no longer pass the staleness check.
+func (h *Handler) markSeen(id string, now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seen.prune(now)
+	if h.seen.has(id) {
+		return false
+	}
+
+	retention := 2 * h.tolerance
+	if retention <= 0 {
+		retention = 24 * time.Hour
+	}
+
+	h.seen.add(id, now.Add(retention))
+	return true
+}
+
+// isRetry reports whether id failed to be handled within the retry window
+func (h *Handler) isRetry(id string, now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.retries.prune(now)
+	return h.retries.has(id)
+}
+
+// failed forgets an event id so it can be redelivered, allowing it to skip the
+// staleness check for the retry window
+func (h *Handler) failed(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seen.remove(id)
+	if h.retryWindow > 0 && !h.retries.has(id) {
+		h.retries.add(id, h.now().Add(h.retryWindow))
+	}
+}
+
+// handled clears the retry state of an event id once its handlers succeed
+func (h *Handler) handled(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.retries.remove(id)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.Verify(string(raw))
+	if errors.Is(err, ErrReplayedEvent) {
+		// kinde redelivers events it missed the acknowledgement for, they were
+		// already handled so acknowledge them again
+		h.logger.Logf("[Handler.ServeHTTP] ignored duplicate webhook: %s\n", err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err != nil {
+		h.logger.Logf("[Handler.ServeHTTP] rejected webhook: %s\n", err)
+		switch {
+		case errors.Is(err, ErrStaleEvent):
+			http.Error(w, "stale event", http.StatusBadRequest)
+		case errors.Is(err, ErrInvalidToken), errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrUnknownKey):
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+		default:
+			http.Error(w, "failed to verify token", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	h.logger.Logf("[Handler.ServeHTTP] received %s event %s\n", event.Type, event.ID)
+
+	if err := h.dispatch(r.Context(), *event); err != nil {
+		// allow kinde to redeliver the event
+		h.failed(event.ID)
+		h.logger.Logf("[Handler.ServeHTTP] failed to handle %s event %s: %s\n", event.Type, event.ID, err)
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+
+	h.handled(event.ID)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event events.Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[event.Type]
+	fallback := h.fallback
+	h.mu.RUnlock()
+
+	if len(handlers) == 0 && fallback != nil {
+		handlers = []HandlerFunc{fallback}
+	}
+
+	for _, fn := range handlers {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
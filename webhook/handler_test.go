@@ -0,0 +1,233 @@
+package webhook_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/events"
+	"github.com/nxt-fwd/kinde-go/api/webhooks"
+	"github.com/nxt-fwd/kinde-go/internal/testutil"
+	"github.com/nxt-fwd/kinde-go/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func newJWKSServer(t *testing.T, kid string, key *rsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	server, _ := newCountingJWKSServer(t, kid, key)
+	return server
+}
+
+func newCountingJWKSServer(t *testing.T, kid string, key *rsa.PrivateKey) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	e := big.NewInt(int64(key.PublicKey.E)).Bytes()
+	body := fmt.Sprintf(`{"keys":[{"kty":"RSA","alg":"RS256","use":"sig","kid":"%s","n":"%s","e":"%s"}]}`,
+		kid,
+		base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(e),
+	)
+
+	fetches := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/.well-known/jwks.json", r.URL.Path)
+		fetches.Add(1)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, fetches
+}
+
+func sign(t *testing.T, kid string, key *rsa.PrivateKey, claims any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func userCreated(id string, timestamp time.Time) map[string]any {
+	return map[string]any{
+		"event_id":  id,
+		"type":      "user.created",
+		"source":    "admin",
+		"timestamp": timestamp.Format(time.RFC3339),
+		"data": map[string]any{
+			"user": map[string]any{
+				"id":            "kp_1",
+				"email":         "jane@example.com",
+				"first_name":    "Jane",
+				"last_name":     "Doe",
+				"phone":         nil,
+				"username":      "jane",
+				"organizations": []map[string]any{{"code": "org_1", "roles": []string{"admin"}}},
+			},
+		},
+	}
+}
+
+func deliver(handler http.Handler, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(token))
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	return res
+}
+
+func TestHandler(t *testing.T) {
+	key := newKey(t)
+	server := newJWKSServer(t, "kid_1", key)
+
+	handler := webhook.NewHandler(webhook.NewHandlerOptions().
+		WithDomain(server.URL).
+		WithLogger(testutil.NewTestLogger(t)))
+
+	var received *events.UserData
+	webhook.On(handler, webhooks.EventTypeUserCreated, func(ctx context.Context, event events.Event, data events.UserData) error {
+		received = &data
+		return nil
+	})
+
+	res := deliver(handler, sign(t, "kid_1", key, userCreated("event_1", time.Now())))
+	assert.Equal(t, http.StatusOK, res.Code)
+	require.NotNil(t, received)
+	assert.Equal(t, "kp_1", received.User.ID)
+	assert.Equal(t, "jane@example.com", received.User.PreferredEmail)
+	assert.Equal(t, "jane", received.Username)
+	require.Len(t, received.Organizations, 1)
+	assert.Equal(t, []string{"admin"}, received.Organizations[0].Roles)
+
+	// duplicates are acknowledged without being handled again
+	received = nil
+	res = deliver(handler, sign(t, "kid_1", key, userCreated("event_1", time.Now())))
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Nil(t, received)
+}
+
+func TestHandlerRejectsInvalidTokens(t *testing.T) {
+	key := newKey(t)
+	server := newJWKSServer(t, "kid_1", key)
+	handler := webhook.NewHandler(webhook.NewHandlerOptions().WithDomain(server.URL))
+
+	called := false
+	handler.HandleDefault(func(ctx context.Context, event events.Event) error {
+		called = true
+		return nil
+	})
+
+	res := deliver(handler, sign(t, "kid_1", newKey(t), userCreated("event_1", time.Now())))
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+	res = deliver(handler, sign(t, "kid_2", key, userCreated("event_2", time.Now())))
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+	res = deliver(handler, "not-a-token")
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+	res = deliver(handler, strings.Repeat("a", 1<<20+1))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+
+	res = deliver(handler, sign(t, "kid_1", key, userCreated("event_3", time.Now().Add(-time.Hour))))
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+
+	assert.False(t, called)
+}
+
+func TestHandlerLimitsKeyRefetches(t *testing.T) {
+	key := newKey(t)
+	server, fetches := newCountingJWKSServer(t, "kid_1", key)
+	handler := webhook.NewHandler(webhook.NewHandlerOptions().WithDomain(server.URL))
+
+	for i := 0; i < 5; i++ {
+		res := deliver(handler, sign(t, fmt.Sprintf("kid_unknown_%d", i), key, userCreated(fmt.Sprintf("event_%d", i), time.Now())))
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+
+	// the initial fetch plus a single forced refetch
+	assert.Equal(t, int32(2), fetches.Load())
+
+	res := deliver(handler, sign(t, "kid_1", key, userCreated("event_known", time.Now())))
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestHandlerRedeliversFailedEvents(t *testing.T) {
+	key := newKey(t)
+	server := newJWKSServer(t, "kid_1", key)
+	handler := webhook.NewHandler(webhook.NewHandlerOptions().WithDomain(server.URL))
+
+	calls := 0
+	handler.Handle(webhooks.EventTypeUserCreated, func(ctx context.Context, event events.Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	token := sign(t, "kid_1", key, userCreated("event_1", time.Now()))
+	assert.Equal(t, http.StatusInternalServerError, deliver(handler, token).Code)
+	assert.Equal(t, http.StatusOK, deliver(handler, token).Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandlerRedeliversFailedEventsAfterTolerance(t *testing.T) {
+	key := newKey(t)
+	server := newJWKSServer(t, "kid_1", key)
+	handler := webhook.NewHandler(webhook.NewHandlerOptions().
+		WithDomain(server.URL).
+		WithTolerance(1500 * time.Millisecond))
+
+	calls := 0
+	handler.Handle(webhooks.EventTypeUserCreated, func(ctx context.Context, event events.Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	token := sign(t, "kid_1", key, userCreated("event_1", time.Now()))
+	assert.Equal(t, http.StatusInternalServerError, deliver(handler, token).Code)
+
+	// redelivered with backoff, once the event is older than the tolerance
+	time.Sleep(2600 * time.Millisecond)
+	assert.Equal(t, http.StatusOK, deliver(handler, token).Code)
+	assert.Equal(t, 2, calls)
+
+	// events that never failed are still rejected once stale
+	stale := sign(t, "kid_1", key, userCreated("event_2", time.Now().Add(-time.Minute)))
+	assert.Equal(t, http.StatusBadRequest, deliver(handler, stale).Code)
+}
@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/internal/cache"
+)
+
+var (
+	ErrInvalidToken     = errors.New("invalid webhook token")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrUnknownKey       = errors.New("unknown webhook signing key")
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type keySet map[string]*rsa.PublicKey
+
+// minRefetchInterval limits how often an unknown key id can force the key set
+// to be refetched, key ids come from unverified tokens
+const minRefetchInterval = time.Minute
+
+// keyStore fetches and caches the business json web key set
+type keyStore struct {
+	memo *cache.Memo[keySet]
+
+	mu          sync.Mutex
+	lastRefetch time.Time
+	now         func() time.Time
+}
+
+func newKeyStore(client *http.Client, endpoint string, lifespan time.Duration) *keyStore {
+	return &keyStore{
+		memo: cache.Memoise(lifespan, func() (*keySet, error) {
+			return fetchKeySet(client, endpoint)
+		}),
+		now: time.Now,
+	}
+}
+
+// allowRefetch reports whether a forced refetch is allowed, recording it
+func (s *keyStore) allowRefetch() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if !s.lastRefetch.IsZero() && now.Sub(s.lastRefetch) < minRefetchInterval {
+		return false
+	}
+
+	s.lastRefetch = now
+	return true
+}
+
+// key looks up a key by id, refetching the key set when the id is unknown to
+// pick up rotated keys, at most once per minRefetchInterval
+func (s *keyStore) key(kid string) (*rsa.PublicKey, error) {
+	keys, err := s.memo.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := (*keys)[kid]; ok {
+		return key, nil
+	}
+
+	if !s.allowRefetch() {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+	}
+
+	s.memo.Expire()
+	keys, err = s.memo.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := (*keys)[kid]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+}
+
+func fetchKeySet(client *http.Client, endpoint string) (*keySet, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwks request: %w", err)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	defer res.Body.Close()
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected jwks status code %d: %s", res.StatusCode, string(raw))
+	}
+
+	var set jwks
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks: %w", err)
+	}
+
+	keys := keySet{}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse jwk %s: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = key
+	}
+
+	return &keys, nil
+}
+
+func (k jwk) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("exponent too large")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+// verify checks the RS256 signature of a compact jwt and returns its payload
+func (s *keyStore) verify(token string) ([]byte, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 segments, got %d", ErrInvalidToken, len(parts))
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode header: %s", ErrInvalidToken, err)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse header: %s", ErrInvalidToken, err)
+	}
+
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode signature: %s", ErrInvalidToken, err)
+	}
+
+	key, err := s.key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payload: %s", ErrInvalidToken, err)
+	}
+
+	return payload, nil
+}